@@ -83,6 +83,18 @@ func (iban IBAN) CountryCode() country.Code {
 	return country.Code(norm[:2])
 }
 
+// BBAN returns the Basic Bank Account Number,
+// that is the normalized IBAN without
+// the country code and check digits,
+// or an empty string if the IBAN is invalid.
+func (iban IBAN) BBAN() string {
+	norm, err := iban.Normalized()
+	if err != nil {
+		return ""
+	}
+	return string(norm[4:])
+}
+
 // Normalized returns the iban in normalized form,
 // or an error if the format can't be detected.
 // Returns the IBAN unchanged in case of an error.
@@ -29,6 +29,12 @@ var toNormalizeIBANs = map[string]IBAN{
 
 var invalidIBANs = []string{
 	"at05 1937 0711 1000 0044",
+	"DE88 3704 0044 0532 0130 00",       // Corrupted check digits
+	"DE89 3704 0044 0532 0130 01",       // Corrupted BBAN
+	"AT62 1904 3002 3457 3201",          // Corrupted check digits
+	"FR15 2004 1010 0505 0001 3M02 606", // Corrupted check digits
+	"DE89 3704 0044 0532 0130 0",        // Wrong length
+	"XX89 3704 0044 0532 0130 00",       // Invalid country
 }
 
 // http://www.rbs.co.uk/corporate/international/g0/guide-to-international-business/regulatory-information/iban/iban-example.ashx
@@ -126,6 +132,15 @@ func Test_NormalizeIBAN(t *testing.T) {
 	}
 }
 
+func TestIBAN_BBAN(t *testing.T) {
+	require.Equal(t, "370400440532013000", IBAN("DE89 3704 0044 0532 0130 00").BBAN())
+	require.Equal(t, "1904300234573201", IBAN("AT611904300234573201").BBAN())
+	require.Equal(t, "20041010050500013M02606", IBAN("FR1420041010050500013M02606").BBAN())
+	require.Equal(t, "", IBAN("DE88370400440532013000").BBAN())
+	require.Equal(t, "", IBANNull.BBAN())
+	require.Equal(t, "370400440532013000", NullableIBAN("DE89370400440532013000").BBAN())
+}
+
 var bankAndAccountNumbersTable = map[IBAN][2]string{
 	"AT252011183728861100": {"20111", "83728861100"},
 }
@@ -69,6 +69,16 @@ func (iban NullableIBAN) CountryCode() country.Code {
 	return country.Code(iban[:2])
 }
 
+// BBAN returns the Basic Bank Account Number
+// of the IBAN or an empty string if
+// the IBAN is null or invalid.
+func (iban NullableIBAN) BBAN() string {
+	if iban.IsNull() {
+		return ""
+	}
+	return IBAN(iban).BBAN()
+}
+
 // Normalized returns the iban in normalized form,
 // or an error if the format can't be detected.
 // Returns the NullableIBAN unchanged in case of an error.
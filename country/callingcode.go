@@ -0,0 +1,29 @@
+package country
+
+import "slices"
+
+// CallingCode returns the E.164 international calling code
+// of the country, like 49 for DE or 1 for US,
+// or false if no code is assigned.
+// Multiple countries may share a calling code,
+// see CountriesForCallingCode.
+func (c Code) CallingCode() (code int, ok bool) {
+	code, ok = countryCallingCode[c.normalized()]
+	return code, ok
+}
+
+// CountriesForCallingCode returns the sorted codes of all countries
+// using an E.164 international calling code.
+// The North American Numbering Plan code 1 for example
+// is shared by US, CA, and several Caribbean countries.
+// EL is not returned because it is an alias for GR.
+func CountriesForCallingCode(code int) []Code {
+	var codes []Code
+	for c, cc := range countryCallingCode {
+		if cc == code && c != EL {
+			codes = append(codes, c)
+		}
+	}
+	slices.Sort(codes)
+	return codes
+}
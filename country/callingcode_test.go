@@ -0,0 +1,59 @@
+package country
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestCode_CallingCode(t *testing.T) {
+	tests := []struct {
+		c      Code
+		want   int
+		wantOK bool
+	}{
+		{c: DE, want: 49, wantOK: true},
+		{c: "at", want: 43, wantOK: true},
+		{c: US, want: 1, wantOK: true},
+		{c: CA, want: 1, wantOK: true},
+		{c: GB, want: 44, wantOK: true},
+		{c: XK, want: 383, wantOK: true},
+		{c: AQ, want: 0, wantOK: false},
+		{c: "xx", want: 0, wantOK: false},
+	}
+	for _, tt := range tests {
+		got, ok := tt.c.CallingCode()
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Code(%q).CallingCode() = %d, %t, want %d, %t", tt.c, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestCountriesForCallingCode(t *testing.T) {
+	nanp := CountriesForCallingCode(1)
+	for _, code := range []Code{US, CA, PR, JM, BS, BB, TT, DO} {
+		if !slices.Contains(nanp, code) {
+			t.Errorf("CountriesForCallingCode(1) does not contain %s", code)
+		}
+	}
+	if !slices.IsSorted(nanp) {
+		t.Errorf("CountriesForCallingCode(1) not sorted: %v", nanp)
+	}
+
+	tests := []struct {
+		code int
+		want []Code
+	}{
+		{code: 49, want: []Code{DE}},
+		{code: 43, want: []Code{AT}},
+		{code: 30, want: []Code{GR}},
+		{code: 7, want: []Code{KZ, RU}},
+		{code: 0, want: nil},
+		{code: 999, want: nil},
+	}
+	for _, tt := range tests {
+		if got := CountriesForCallingCode(tt.code); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("CountriesForCallingCode(%d) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
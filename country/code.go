@@ -4,8 +4,11 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/domonda/go-types/date"
+	"github.com/domonda/go-types/language"
 	"github.com/domonda/go-types/strutil"
 )
 
@@ -41,26 +44,162 @@ func (c Code) normalized() Code {
 	return Code(strings.ToUpper(strutil.TrimSpace(string(c))))
 }
 
-// NormalizedWithAltCodes uses AltCodes to map
-// to ISO 3166-1 alpha 2 codes or return the
+// NormalizedWithAltCodes uses AltCodes and ISO 3166-1 alpha 3 codes
+// to map to ISO 3166-1 alpha 2 codes or return the
 // result of Normalized() if no mapping exists.
 func (c Code) NormalizedWithAltCodes() (Code, error) {
 	if norm, ok := AltCodes[strings.ToUpper(strutil.TrimSpace(string(c)))]; ok {
 		return norm, nil
 	}
+	if norm, ok := NormalizeAlpha3(string(c)); ok {
+		return norm, nil
+	}
 	return c.Normalized()
 }
 
+// NormalizeAlpha3 returns the Code for a
+// case insensitive ISO 3166-1 alpha 3 code like "DEU" for DE.
+func NormalizeAlpha3(alpha3 string) (Code, bool) {
+	code, ok := alpha3ToAlpha2[strings.ToUpper(strutil.TrimSpace(alpha3))]
+	return code, ok
+}
+
+// Numeric returns the ISO 3166-1 numeric code
+// of the country, like 276 for DE,
+// or zero for invalid codes.
+// XK returns the unofficial but commonly used 383.
+func (c Code) Numeric() int {
+	return numericCodes[c.normalized()]
+}
+
+// FromNumeric returns the Code for an
+// ISO 3166-1 numeric code like 276 for DE.
+// Zero and unknown numeric codes return false.
+func FromNumeric(n int) (Code, bool) {
+	code, ok := numericToCode[n]
+	return code, ok
+}
+
+// Alpha3 returns the ISO 3166-1 alpha 3 code
+// of the country, like "DEU" for DE,
+// or an empty string for invalid codes.
+// XK returns the unofficial but commonly used "XKX".
+func (c Code) Alpha3() string {
+	return alpha3Codes[c.normalized()]
+}
+
 // IsEU indicates if a country is member of the European Union
 func (c Code) IsEU() bool {
 	_, ok := euCountries[c.normalized()]
 	return ok
 }
 
+// IsEUOn indicates if a country was member of the European Union
+// or its predecessor the European Economic Community at the passed date,
+// like GB until 2020-01-31 or HR since 2013-07-01.
+// False is returned for invalid dates.
+// Use IsEU for the current membership.
+func (c Code) IsEUOn(d date.Date) bool {
+	membership, ok := euMembership[c.normalized()]
+	if !ok || !d.Valid() {
+		return false
+	}
+	return !d.Before(membership.joined) && (membership.left == "" || !d.After(membership.left))
+}
+
 func (c Code) EnglishName() string {
 	return countryMap[c.normalized()]
 }
 
+// PrimaryLanguage returns the dominant official language
+// of the country, like language.DE for AT or CH,
+// or language.Null for invalid codes and
+// uninhabited territories like AQ.
+func (c Code) PrimaryLanguage() language.Code {
+	return countryPrimaryLanguage[c.normalized()]
+}
+
+// CcTLD returns the country code top-level domain
+// including the leading dot, like ".de" for DE.
+// GB uses ".uk" instead of the ISO code ".gb".
+// An empty string is returned for invalid codes
+// and countries without an assigned ccTLD like XK.
+func (c Code) CcTLD() string {
+	norm := c.normalized()
+	if _, ok := countryMap[norm]; !ok {
+		return ""
+	}
+	if tld, ok := ccTLDExceptions[norm]; ok {
+		return tld
+	}
+	return "." + strings.ToLower(string(norm))
+}
+
+// CodeFromCcTLD returns the country Code for a
+// country code top-level domain with or without leading dot.
+// Both ".uk" and ".gb" map to GB.
+// The pseudo-TLD ".eu" of the European Union
+// is not a country and returns false.
+func CodeFromCcTLD(tld string) (Code, bool) {
+	tld = strings.ToLower(strutil.TrimSpace(tld))
+	tld = strings.TrimPrefix(tld, ".")
+	switch tld {
+	case "uk", "gb":
+		return GB, true
+	case "eu", "":
+		return Invalid, false
+	}
+	code := Code(strings.ToUpper(tld))
+	if _, ok := countryMap[code]; !ok {
+		return Invalid, false
+	}
+	if _, ok := ccTLDExceptions[code]; ok {
+		return Invalid, false
+	}
+	return code, true
+}
+
+// Parse returns the Code for an ISO 3166-1 alpha-2 code,
+// an ISO 3166-1 alpha-3 code, an alternative code from AltCodes,
+// an ISO 3166-1 numeric code,
+// or a case insensitive English or German country name.
+func Parse(s string) (Code, error) {
+	s = strutil.TrimSpace(s)
+	if code, err := Code(s).NormalizedWithAltCodes(); err == nil {
+		return code, nil
+	}
+	if num, err := strconv.Atoi(s); err == nil {
+		if code, ok := numericToCode[num]; ok {
+			return code, nil
+		}
+		return Invalid, fmt.Errorf("invalid numeric country code: %q", s)
+	}
+	for _, names := range countryNames(nil) {
+		for code, name := range names {
+			// EL has the same name as its ISO code GR
+			if code != EL && strings.EqualFold(s, name) {
+				return code, nil
+			}
+		}
+	}
+	return Invalid, fmt.Errorf("can't parse country: %q", s)
+}
+
+// NormalizeCodes parses all inputs with Parse and
+// returns the parsed codes as valid and the inputs
+// that could not be parsed as invalid, both in input order.
+func NormalizeCodes(inputs []string) (valid []Code, invalid []string) {
+	for _, input := range inputs {
+		code, err := Parse(input)
+		if err != nil {
+			invalid = append(invalid, input)
+			continue
+		}
+		valid = append(valid, code)
+	}
+	return valid, invalid
+}
+
 // Scan implements the database/sql.Scanner interface.
 func (c *Code) Scan(value any) error {
 	switch x := value.(type) {
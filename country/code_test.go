@@ -1,6 +1,13 @@
 package country
 
-import "testing"
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/domonda/go-types/date"
+	"github.com/domonda/go-types/language"
+)
 
 func TestCode_NormalizedWithAltCodes(t *testing.T) {
 	tests := []struct {
@@ -29,3 +36,324 @@ func TestCode_NormalizedWithAltCodes(t *testing.T) {
 		})
 	}
 }
+
+func TestCode_CcTLD(t *testing.T) {
+	tests := []struct {
+		c    Code
+		want string
+	}{
+		{c: DE, want: ".de"},
+		{c: "at", want: ".at"},
+		{c: US, want: ".us"},
+		{c: GB, want: ".uk"},
+		{c: XK, want: ""},
+		{c: "", want: ""},
+		{c: "XX", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.c), func(t *testing.T) {
+			if got := tt.c.CcTLD(); got != tt.want {
+				t.Errorf("Code.CcTLD() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCodeFromCcTLD(t *testing.T) {
+	tests := []struct {
+		tld    string
+		want   Code
+		wantOK bool
+	}{
+		{tld: ".de", want: DE, wantOK: true},
+		{tld: "DE", want: DE, wantOK: true},
+		{tld: ".uk", want: GB, wantOK: true},
+		{tld: ".gb", want: GB, wantOK: true},
+		// Not a country
+		{tld: ".eu", want: Invalid, wantOK: false},
+		{tld: ".xk", want: Invalid, wantOK: false},
+		{tld: ".zz", want: Invalid, wantOK: false},
+		{tld: ".com", want: Invalid, wantOK: false},
+		{tld: "", want: Invalid, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tld, func(t *testing.T) {
+			got, ok := CodeFromCcTLD(tt.tld)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("CodeFromCcTLD(%q) = %v, %v, want %v, %v", tt.tld, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCodeFromNameFuzzy(t *testing.T) {
+	tests := []struct {
+		s           string
+		maxDistance int
+		lang        []language.Code
+		want        Code
+		wantOK      bool
+	}{
+		{s: "Deutschand", maxDistance: 2, want: DE, wantOK: true},
+		{s: "Deutschland", maxDistance: 0, want: DE, wantOK: true},
+		{s: "Ostereich", maxDistance: 2, want: AT, wantOK: true},
+		{s: "Swizerland", maxDistance: 2, want: CH, wantOK: true},
+		{s: "  GERMNY ", maxDistance: 1, want: DE, wantOK: true},
+		{s: "Deutschand", maxDistance: 2, lang: []language.Code{language.DE}, want: DE, wantOK: true},
+		// Not matching
+		{s: "Deutschand", maxDistance: 2, lang: []language.Code{language.EN}, want: Invalid, wantOK: false},
+		{s: "Deutschand", maxDistance: 0, want: Invalid, wantOK: false},
+		{s: "Atlantis", maxDistance: 2, want: Invalid, wantOK: false},
+		{s: "Chn", maxDistance: 1, want: Invalid, wantOK: false}, // too short
+		{s: "", maxDistance: 2, want: Invalid, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, ok := CodeFromNameFuzzy(tt.s, tt.maxDistance, tt.lang...)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("CodeFromNameFuzzy(%q, %d) = %v, %v, want %v, %v", tt.s, tt.maxDistance, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCodeByName(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   Code
+		wantOK bool
+	}{
+		{name: "Österreich", want: AT, wantOK: true},
+		{name: "österreich", want: AT, wantOK: true},
+		{name: "OSTERREICH", want: AT, wantOK: true},
+		{name: "Oesterreich", want: AT, wantOK: true},
+		{name: "AUSTRIA", want: AT, wantOK: true},
+		{name: " united kingdom ", want: GB, wantOK: true},
+		{name: "Deutschland", want: DE, wantOK: true},
+		{name: "Turkei", want: TR, wantOK: true},
+		{name: "Atlantis", want: Invalid, wantOK: false},
+		{name: "", want: Invalid, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := CodeByName(tt.name)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("CodeByName(%q) = %v, %v, want %v, %v", tt.name, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCodeByName_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if code, ok := CodeByName("Österreich"); !ok || code != AT {
+					t.Errorf("CodeByName(Österreich) = %q, %t", code, ok)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCode_IsEUOn(t *testing.T) {
+	tests := []struct {
+		c    Code
+		d    date.Date
+		want bool
+	}{
+		{c: GB, d: "2019-12-31", want: true},
+		{c: GB, d: "2020-01-31", want: true},
+		{c: GB, d: "2020-02-01", want: false},
+		{c: GB, d: "2020-06-01", want: false},
+		{c: GB, d: "1972-12-31", want: false},
+		{c: HR, d: "2010-01-01", want: false},
+		{c: HR, d: "2013-06-30", want: false},
+		{c: HR, d: "2013-07-01", want: true},
+		{c: AT, d: "1994-12-31", want: false},
+		{c: AT, d: "1995-01-01", want: true},
+		{c: "de", d: "2024-01-01", want: true},
+		{c: CH, d: "2024-01-01", want: false},
+		{c: DE, d: "", want: false},
+		{c: DE, d: "invalid", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.c)+" "+string(tt.d), func(t *testing.T) {
+			if got := tt.c.IsEUOn(tt.d); got != tt.want {
+				t.Errorf("Code(%q).IsEUOn(%q) = %v, want %v", tt.c, tt.d, got, tt.want)
+			}
+		})
+	}
+
+	// Current membership must match IsEU
+	today := date.OfToday()
+	for c := range countryMap {
+		if c.IsEUOn(today) != c.IsEU() {
+			t.Errorf("Code(%q).IsEUOn(today) != IsEU()", c)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Code
+		wantErr bool
+	}{
+		{s: "DE", want: DE},
+		{s: " de ", want: DE},
+		{s: "DEU", want: DE},
+		{s: "Deutschland", want: DE},
+		{s: "germany", want: DE},
+		{s: "276", want: DE},
+		{s: "040", want: AT},
+		{s: "Greece", want: GR},
+		{s: "300", want: GR},
+		{s: "999", wantErr: true},
+		{s: "Atlantis", wantErr: true},
+		{s: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := Parse(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeCodes(t *testing.T) {
+	valid, invalid := NormalizeCodes([]string{"DE", "Deutschland", "xyz", "276", "", "Österreich", "Not a country"})
+	wantValid := []Code{DE, DE, DE, AT}
+	wantInvalid := []string{"xyz", "", "Not a country"}
+	if !reflect.DeepEqual(valid, wantValid) {
+		t.Errorf("NormalizeCodes() valid = %v, want %v", valid, wantValid)
+	}
+	if !reflect.DeepEqual(invalid, wantInvalid) {
+		t.Errorf("NormalizeCodes() invalid = %q, want %q", invalid, wantInvalid)
+	}
+}
+
+func TestCode_PrimaryLanguage(t *testing.T) {
+	tests := []struct {
+		c    Code
+		want language.Code
+	}{
+		{c: DE, want: language.DE},
+		{c: AT, want: language.DE},
+		{c: CH, want: language.DE},
+		{c: FR, want: language.FR},
+		{c: US, want: language.EN},
+		{c: GB, want: language.EN},
+		{c: "es", want: language.ES},
+		{c: BR, want: language.PT},
+		{c: EL, want: language.EL},
+		{c: AQ, want: language.Null},
+		{c: "xx", want: language.Null},
+		{c: Invalid, want: language.Null},
+	}
+	for _, tt := range tests {
+		if got := tt.c.PrimaryLanguage(); got != tt.want {
+			t.Errorf("Code(%q).PrimaryLanguage() = %q, want %q", tt.c, got, tt.want)
+		}
+	}
+	for code, lang := range countryPrimaryLanguage {
+		if !lang.Valid() {
+			t.Errorf("%s.PrimaryLanguage() = %q is not valid", code, lang)
+		}
+	}
+}
+
+func TestCode_Alpha3(t *testing.T) {
+	for code := range countryMap {
+		alpha3 := code.Alpha3()
+		if len(alpha3) != 3 {
+			t.Errorf("%s.Alpha3() = %q", code, alpha3)
+			continue
+		}
+		want := code
+		if code == EL {
+			want = GR // EL is an alias for GR
+		}
+		if got, ok := NormalizeAlpha3(alpha3); !ok || got != want {
+			t.Errorf("NormalizeAlpha3(%q) = %q, %t, want %q", alpha3, got, ok, want)
+		}
+	}
+
+	tests := []struct {
+		alpha3 string
+		want   Code
+		wantOK bool
+	}{
+		{alpha3: "DEU", want: DE, wantOK: true},
+		{alpha3: " aut ", want: AT, wantOK: true},
+		{alpha3: "CHE", want: CH, wantOK: true},
+		{alpha3: "GBR", want: GB, wantOK: true},
+		{alpha3: "XKX", want: XK, wantOK: true},
+		{alpha3: "DE", want: Invalid, wantOK: false},
+		{alpha3: "XXX", want: Invalid, wantOK: false},
+	}
+	for _, tt := range tests {
+		got, ok := NormalizeAlpha3(tt.alpha3)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("NormalizeAlpha3(%q) = %q, %t, want %q, %t", tt.alpha3, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	if got, err := Code("usa").NormalizedWithAltCodes(); err != nil || got != US {
+		t.Errorf("Code(usa).NormalizedWithAltCodes() = %q, %v", got, err)
+	}
+	if got, err := Parse("FRA"); err != nil || got != FR {
+		t.Errorf("Parse(FRA) = %q, %v", got, err)
+	}
+	if got := Code("xx").Alpha3(); got != "" {
+		t.Errorf("Code(xx).Alpha3() = %q", got)
+	}
+}
+
+func TestCode_Numeric(t *testing.T) {
+	for code := range countryMap {
+		num := code.Numeric()
+		if num == 0 {
+			t.Errorf("%s has no numeric code", code)
+			continue
+		}
+		want := code
+		if code == EL {
+			want = GR // EL is an alias for GR
+		}
+		if got, ok := FromNumeric(num); !ok || got != want {
+			t.Errorf("FromNumeric(%d) = %q, %t, want %q", num, got, ok, want)
+		}
+	}
+
+	if num := DE.Numeric(); num != 276 {
+		t.Errorf("DE.Numeric() = %d, want 276", num)
+	}
+	if num := Code("us").Numeric(); num != 840 {
+		t.Errorf("US.Numeric() = %d, want 840", num)
+	}
+	if got, ok := FromNumeric(276); !ok || got != DE {
+		t.Errorf("FromNumeric(276) = %q, %t", got, ok)
+	}
+	if got, ok := FromNumeric(840); !ok || got != US {
+		t.Errorf("FromNumeric(840) = %q, %t", got, ok)
+	}
+	for _, n := range []int{0, -1, 999} {
+		if got, ok := FromNumeric(n); ok || got != Invalid {
+			t.Errorf("FromNumeric(%d) = %q, %t", n, got, ok)
+		}
+	}
+	if num := Code("xx").Numeric(); num != 0 {
+		t.Errorf("Code(xx).Numeric() = %d", num)
+	}
+}
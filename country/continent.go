@@ -0,0 +1,64 @@
+package country
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Continent is the English name of a continent
+// as used for the continent grouping of countries.
+type Continent string
+
+const (
+	Africa       Continent = "Africa"
+	Antarctica   Continent = "Antarctica"
+	Asia         Continent = "Asia"
+	Europe       Continent = "Europe"
+	NorthAmerica Continent = "North America"
+	Oceania      Continent = "Oceania"
+	SouthAmerica Continent = "South America"
+)
+
+// Continents returns all continents in alphabetical order.
+func Continents() []Continent {
+	return []Continent{Africa, Antarctica, Asia, Europe, NorthAmerica, Oceania, SouthAmerica}
+}
+
+func (c Continent) Valid() bool {
+	return slices.Contains(Continents(), c)
+}
+
+func (c Continent) Validate() error {
+	if c.Valid() {
+		return nil
+	}
+	return fmt.Errorf("invalid country.Continent: %q", string(c))
+}
+
+// String implements the fmt.Stringer interface.
+func (c Continent) String() string {
+	return string(c)
+}
+
+// Continent returns the continent of the country
+// or an empty string for invalid codes.
+// Transcontinental countries are assigned to the
+// continent of their capital city,
+// so RU is in Europe and TR is in Asia.
+func (c Code) Continent() Continent {
+	return countryContinent[c.normalized()]
+}
+
+// CountriesInContinent returns the sorted codes of all
+// countries in a continent.
+// EL is not returned because it is an alias for GR.
+func CountriesInContinent(cont Continent) []Code {
+	var codes []Code
+	for code, c := range countryContinent {
+		if c == cont && code != EL {
+			codes = append(codes, code)
+		}
+	}
+	slices.Sort(codes)
+	return codes
+}
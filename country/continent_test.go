@@ -0,0 +1,59 @@
+package country
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCode_Continent(t *testing.T) {
+	for code := range countryMap {
+		if !code.Continent().Valid() {
+			t.Errorf("%s.Continent() = %q is not valid", code, code.Continent())
+		}
+	}
+
+	tests := []struct {
+		c    Code
+		want Continent
+	}{
+		{c: "de", want: Europe},
+		{c: AT, want: Europe},
+		{c: XK, want: Europe},
+		{c: RU, want: Europe},
+		{c: TR, want: Asia},
+		{c: AQ, want: Antarctica},
+		{c: US, want: NorthAmerica},
+		{c: BR, want: SouthAmerica},
+		{c: AU, want: Oceania},
+		{c: ZA, want: Africa},
+		{c: "xx", want: ""},
+	}
+	for _, tt := range tests {
+		if got := tt.c.Continent(); got != tt.want {
+			t.Errorf("Code(%q).Continent() = %q, want %q", tt.c, got, tt.want)
+		}
+	}
+}
+
+func TestCountriesInContinent(t *testing.T) {
+	num := 0
+	for _, cont := range Continents() {
+		codes := CountriesInContinent(cont)
+		if !slices.IsSorted(codes) {
+			t.Errorf("CountriesInContinent(%q) not sorted", cont)
+		}
+		num += len(codes)
+	}
+	if want := len(countryMap) - 1; num != want { // without EL
+		t.Errorf("CountriesInContinent() returned %d countries, want %d", num, want)
+	}
+	if !slices.Contains(CountriesInContinent(Europe), DE) {
+		t.Errorf("CountriesInContinent(Europe) does not contain DE")
+	}
+	if slices.Contains(CountriesInContinent(Europe), EL) {
+		t.Errorf("CountriesInContinent(Europe) contains alias EL")
+	}
+	if codes := CountriesInContinent("Atlantis"); len(codes) != 0 {
+		t.Errorf("CountriesInContinent(Atlantis) = %v, want empty", codes)
+	}
+}
@@ -1,5 +1,10 @@
 package country
 
+import (
+	"github.com/domonda/go-types/date"
+	"github.com/domonda/go-types/language"
+)
+
 const (
 	AF Code = "AF"
 	AX Code = "AX"
@@ -527,6 +532,14 @@ var countryMap = map[Code]string{
 	XK: "Republic of Kosovo", // unofficial, but still can be used
 }
 
+// ccTLDExceptions maps countries whose
+// ccTLD is not the lower case ISO code
+// or that have no ccTLD (empty string).
+var ccTLDExceptions = map[Code]string{
+	GB: ".uk",
+	XK: "", // unofficial code without ccTLD
+}
+
 var euCountries = map[Code]struct{}{
 	AT: {},
 	BE: {},
@@ -556,3 +569,1410 @@ var euCountries = map[Code]struct{}{
 	ES: {},
 	SE: {},
 }
+
+// euMembership holds the dates when countries joined the
+// European Union or its predecessor the European Economic Community
+// and the last day of membership for countries that left it.
+var euMembership = map[Code]struct{ joined, left date.Date }{
+	BE: {joined: "1958-01-01"},
+	DE: {joined: "1958-01-01"},
+	FR: {joined: "1958-01-01"},
+	IT: {joined: "1958-01-01"},
+	LU: {joined: "1958-01-01"},
+	NL: {joined: "1958-01-01"},
+	DK: {joined: "1973-01-01"},
+	IE: {joined: "1973-01-01"},
+	GB: {joined: "1973-01-01", left: "2020-01-31"},
+	GR: {joined: "1981-01-01"},
+	ES: {joined: "1986-01-01"},
+	PT: {joined: "1986-01-01"},
+	AT: {joined: "1995-01-01"},
+	FI: {joined: "1995-01-01"},
+	SE: {joined: "1995-01-01"},
+	CY: {joined: "2004-05-01"},
+	CZ: {joined: "2004-05-01"},
+	EE: {joined: "2004-05-01"},
+	HU: {joined: "2004-05-01"},
+	LV: {joined: "2004-05-01"},
+	LT: {joined: "2004-05-01"},
+	MT: {joined: "2004-05-01"},
+	PL: {joined: "2004-05-01"},
+	SK: {joined: "2004-05-01"},
+	SI: {joined: "2004-05-01"},
+	BG: {joined: "2007-01-01"},
+	RO: {joined: "2007-01-01"},
+	HR: {joined: "2013-07-01"},
+}
+
+// germanNames holds the German names of countries
+// commonly found in documents from German speaking regions.
+var germanNames = map[Code]string{
+	AD: "Andorra",
+	AE: "Vereinigte Arabische Emirate",
+	AL: "Albanien",
+	AR: "Argentinien",
+	AT: "Österreich",
+	AU: "Australien",
+	BA: "Bosnien und Herzegowina",
+	BE: "Belgien",
+	BG: "Bulgarien",
+	BR: "Brasilien",
+	BY: "Weißrussland",
+	CA: "Kanada",
+	CH: "Schweiz",
+	CL: "Chile",
+	CN: "China",
+	CY: "Zypern",
+	CZ: "Tschechien",
+	DE: "Deutschland",
+	DK: "Dänemark",
+	EE: "Estland",
+	EG: "Ägypten",
+	ES: "Spanien",
+	FI: "Finnland",
+	FR: "Frankreich",
+	GB: "Großbritannien",
+	GR: "Griechenland",
+	HK: "Hongkong",
+	HR: "Kroatien",
+	HU: "Ungarn",
+	IE: "Irland",
+	IL: "Israel",
+	IN: "Indien",
+	IS: "Island",
+	IT: "Italien",
+	JP: "Japan",
+	KR: "Südkorea",
+	LI: "Liechtenstein",
+	LT: "Litauen",
+	LU: "Luxemburg",
+	LV: "Lettland",
+	MC: "Monaco",
+	MD: "Moldau",
+	ME: "Montenegro",
+	MK: "Nordmazedonien",
+	MT: "Malta",
+	MX: "Mexiko",
+	NL: "Niederlande",
+	NO: "Norwegen",
+	NZ: "Neuseeland",
+	PL: "Polen",
+	PT: "Portugal",
+	RO: "Rumänien",
+	RS: "Serbien",
+	RU: "Russland",
+	SA: "Saudi-Arabien",
+	SE: "Schweden",
+	SG: "Singapur",
+	SI: "Slowenien",
+	SK: "Slowakei",
+	SM: "San Marino",
+	TR: "Türkei",
+	TW: "Taiwan",
+	UA: "Ukraine",
+	US: "Vereinigte Staaten",
+	VA: "Vatikanstadt",
+	XK: "Kosovo",
+	ZA: "Südafrika",
+}
+
+// countryContinent maps countries to their continent.
+// Transcontinental countries are assigned to the continent
+// of their capital city: RU to Europe, TR, KZ, GE, AZ, and CY to Asia,
+// EG to Africa. Sub-antarctic islands are assigned to Antarctica
+// and Pacific islands to Oceania.
+var countryContinent = map[Code]Continent{
+	AF: Asia,
+	AX: Europe,
+	AL: Europe,
+	DZ: Africa,
+	AS: Oceania,
+	AD: Europe,
+	AO: Africa,
+	AI: NorthAmerica,
+	AQ: Antarctica,
+	AG: NorthAmerica,
+	AR: SouthAmerica,
+	AM: Asia,
+	AW: NorthAmerica,
+	AU: Oceania,
+	AT: Europe,
+	AZ: Asia, // transcontinental, capital Baku in Asia
+	BS: NorthAmerica,
+	BH: Asia,
+	BD: Asia,
+	BB: NorthAmerica,
+	BY: Europe,
+	BE: Europe,
+	BZ: NorthAmerica,
+	BJ: Africa,
+	BM: NorthAmerica,
+	BT: Asia,
+	BO: SouthAmerica,
+	BQ: NorthAmerica,
+	BA: Europe,
+	BW: Africa,
+	BV: Antarctica, // sub-antarctic island
+	BR: SouthAmerica,
+	IO: Asia,
+	BN: Asia,
+	BG: Europe,
+	BF: Africa,
+	BI: Africa,
+	KH: Asia,
+	CM: Africa,
+	CA: NorthAmerica,
+	CV: Africa,
+	KY: NorthAmerica,
+	CF: Africa,
+	TD: Africa,
+	CL: SouthAmerica,
+	CN: Asia,
+	CX: Asia,
+	CC: Asia,
+	CO: SouthAmerica,
+	KM: Africa,
+	CG: Africa,
+	CD: Africa,
+	CK: Oceania,
+	CR: NorthAmerica,
+	CI: Africa,
+	HR: Europe,
+	CU: NorthAmerica,
+	CW: NorthAmerica,
+	CY: Asia, // EU member, but geographically in Asia
+	CZ: Europe,
+	DK: Europe,
+	DJ: Africa,
+	DM: NorthAmerica,
+	DO: NorthAmerica,
+	EC: SouthAmerica,
+	EG: Africa, // transcontinental, capital Cairo in Africa
+	SV: NorthAmerica,
+	GQ: Africa,
+	ER: Africa,
+	EE: Europe,
+	ET: Africa,
+	FK: SouthAmerica,
+	FO: Europe,
+	FJ: Oceania,
+	FI: Europe,
+	FR: Europe,
+	GF: SouthAmerica,
+	PF: Oceania,
+	TF: Antarctica, // sub-antarctic islands
+	GA: Africa,
+	GM: Africa,
+	GE: Asia, // transcontinental, capital Tbilisi in Asia
+	DE: Europe,
+	GH: Africa,
+	GI: Europe,
+	GR: Europe,
+	EL: Europe,
+	GL: NorthAmerica,
+	GD: NorthAmerica,
+	GP: NorthAmerica,
+	GU: Oceania,
+	GT: NorthAmerica,
+	GG: Europe,
+	GN: Africa,
+	GW: Africa,
+	GY: SouthAmerica,
+	HT: NorthAmerica,
+	HM: Antarctica, // sub-antarctic islands
+	VA: Europe,
+	HN: NorthAmerica,
+	HK: Asia,
+	HU: Europe,
+	IS: Europe,
+	IN: Asia,
+	ID: Asia,
+	IR: Asia,
+	IQ: Asia,
+	IE: Europe,
+	IM: Europe,
+	IL: Asia,
+	IT: Europe,
+	JM: NorthAmerica,
+	JP: Asia,
+	JE: Europe,
+	JO: Asia,
+	KZ: Asia, // transcontinental, capital Astana in Asia
+	KE: Africa,
+	KI: Oceania,
+	KP: Asia,
+	KR: Asia,
+	KW: Asia,
+	KG: Asia,
+	LA: Asia,
+	LV: Europe,
+	LB: Asia,
+	LS: Africa,
+	LR: Africa,
+	LY: Africa,
+	LI: Europe,
+	LT: Europe,
+	LU: Europe,
+	MO: Asia,
+	MK: Europe,
+	MG: Africa,
+	MW: Africa,
+	MY: Asia,
+	MV: Asia,
+	ML: Africa,
+	MT: Europe,
+	MH: Oceania,
+	MQ: NorthAmerica,
+	MR: Africa,
+	MU: Africa,
+	YT: Africa,
+	MX: NorthAmerica,
+	FM: Oceania,
+	MD: Europe,
+	MC: Europe,
+	MN: Asia,
+	ME: Europe,
+	MS: NorthAmerica,
+	MA: Africa,
+	MZ: Africa,
+	MM: Asia,
+	NA: Africa,
+	NR: Oceania,
+	NP: Asia,
+	NL: Europe,
+	NC: Oceania,
+	NZ: Oceania,
+	NI: NorthAmerica,
+	NE: Africa,
+	NG: Africa,
+	NU: Oceania,
+	NF: Oceania,
+	MP: Oceania,
+	NO: Europe,
+	OM: Asia,
+	PK: Asia,
+	PW: Oceania,
+	PS: Asia,
+	PA: NorthAmerica,
+	PG: Oceania,
+	PY: SouthAmerica,
+	PE: SouthAmerica,
+	PH: Asia,
+	PN: Oceania,
+	PL: Europe,
+	PT: Europe,
+	PR: NorthAmerica,
+	QA: Asia,
+	RE: Africa,
+	RO: Europe,
+	RU: Europe, // transcontinental, capital Moscow in Europe
+	RW: Africa,
+	BL: NorthAmerica,
+	SH: Africa,
+	KN: NorthAmerica,
+	LC: NorthAmerica,
+	MF: NorthAmerica,
+	PM: NorthAmerica,
+	VC: NorthAmerica,
+	WS: Oceania,
+	SM: Europe,
+	ST: Africa,
+	SA: Asia,
+	SN: Africa,
+	RS: Europe,
+	SC: Africa,
+	SL: Africa,
+	SG: Asia,
+	SX: NorthAmerica,
+	SK: Europe,
+	SI: Europe,
+	SB: Oceania,
+	SO: Africa,
+	ZA: Africa,
+	GS: Antarctica, // sub-antarctic islands
+	SS: Africa,
+	ES: Europe,
+	LK: Asia,
+	SD: Africa,
+	SR: SouthAmerica,
+	SJ: Europe,
+	SZ: Africa,
+	SE: Europe,
+	CH: Europe,
+	SY: Asia,
+	TW: Asia,
+	TJ: Asia,
+	TZ: Africa,
+	TH: Asia,
+	TL: Asia,
+	TG: Africa,
+	TK: Oceania,
+	TO: Oceania,
+	TT: NorthAmerica,
+	TN: Africa,
+	TR: Asia, // transcontinental, capital Ankara in Asia
+	TM: Asia,
+	TC: NorthAmerica,
+	TV: Oceania,
+	UG: Africa,
+	UA: Europe,
+	AE: Asia,
+	GB: Europe,
+	US: NorthAmerica,
+	UM: Oceania,
+	UY: SouthAmerica,
+	UZ: Asia,
+	VU: Oceania,
+	VE: SouthAmerica,
+	VN: Asia,
+	VG: NorthAmerica,
+	VI: NorthAmerica,
+	WF: Oceania,
+	EH: Africa,
+	YE: Asia,
+	ZM: Africa,
+	ZW: Africa,
+	XK: Europe, // unofficial code
+}
+
+// countryCallingCode maps countries to their
+// E.164 international calling code.
+// Uninhabited territories without an assigned code
+// like AQ, BV, HM, TF, and UM are not included.
+var countryCallingCode = map[Code]int{
+	AF: 93,
+	AX: 358,
+	AL: 355,
+	DZ: 213,
+	AS: 1,
+	AD: 376,
+	AO: 244,
+	AI: 1,
+	AG: 1,
+	AR: 54,
+	AM: 374,
+	AW: 297,
+	AU: 61,
+	AT: 43,
+	AZ: 994,
+	BS: 1,
+	BH: 973,
+	BD: 880,
+	BB: 1,
+	BY: 375,
+	BE: 32,
+	BZ: 501,
+	BJ: 229,
+	BM: 1,
+	BT: 975,
+	BO: 591,
+	BQ: 599,
+	BA: 387,
+	BW: 267,
+	BR: 55,
+	IO: 246,
+	BN: 673,
+	BG: 359,
+	BF: 226,
+	BI: 257,
+	KH: 855,
+	CM: 237,
+	CA: 1,
+	CV: 238,
+	KY: 1,
+	CF: 236,
+	TD: 235,
+	CL: 56,
+	CN: 86,
+	CX: 61,
+	CC: 61,
+	CO: 57,
+	KM: 269,
+	CG: 242,
+	CD: 243,
+	CK: 682,
+	CR: 506,
+	CI: 225,
+	HR: 385,
+	CU: 53,
+	CW: 599,
+	CY: 357,
+	CZ: 420,
+	DK: 45,
+	DJ: 253,
+	DM: 1,
+	DO: 1,
+	EC: 593,
+	EG: 20,
+	SV: 503,
+	GQ: 240,
+	ER: 291,
+	EE: 372,
+	ET: 251,
+	FK: 500,
+	FO: 298,
+	FJ: 679,
+	FI: 358,
+	FR: 33,
+	GF: 594,
+	PF: 689,
+	GA: 241,
+	GM: 220,
+	GE: 995,
+	DE: 49,
+	GH: 233,
+	GI: 350,
+	GR: 30,
+	EL: 30,
+	GL: 299,
+	GD: 1,
+	GP: 590,
+	GU: 1,
+	GT: 502,
+	GG: 44,
+	GN: 224,
+	GW: 245,
+	GY: 592,
+	HT: 509,
+	VA: 39, // reserved 379 is unused
+	HN: 504,
+	HK: 852,
+	HU: 36,
+	IS: 354,
+	IN: 91,
+	ID: 62,
+	IR: 98,
+	IQ: 964,
+	IE: 353,
+	IM: 44,
+	IL: 972,
+	IT: 39,
+	JM: 1,
+	JP: 81,
+	JE: 44,
+	JO: 962,
+	KZ: 7,
+	KE: 254,
+	KI: 686,
+	KP: 850,
+	KR: 82,
+	KW: 965,
+	KG: 996,
+	LA: 856,
+	LV: 371,
+	LB: 961,
+	LS: 266,
+	LR: 231,
+	LY: 218,
+	LI: 423,
+	LT: 370,
+	LU: 352,
+	MO: 853,
+	MK: 389,
+	MG: 261,
+	MW: 265,
+	MY: 60,
+	MV: 960,
+	ML: 223,
+	MT: 356,
+	MH: 692,
+	MQ: 596,
+	MR: 222,
+	MU: 230,
+	YT: 262,
+	MX: 52,
+	FM: 691,
+	MD: 373,
+	MC: 377,
+	MN: 976,
+	ME: 382,
+	MS: 1,
+	MA: 212,
+	MZ: 258,
+	MM: 95,
+	NA: 264,
+	NR: 674,
+	NP: 977,
+	NL: 31,
+	NC: 687,
+	NZ: 64,
+	NI: 505,
+	NE: 227,
+	NG: 234,
+	NU: 683,
+	NF: 672,
+	MP: 1,
+	NO: 47,
+	OM: 968,
+	PK: 92,
+	PW: 680,
+	PS: 970,
+	PA: 507,
+	PG: 675,
+	PY: 595,
+	PE: 51,
+	PH: 63,
+	PN: 64,
+	PL: 48,
+	PT: 351,
+	PR: 1,
+	QA: 974,
+	RE: 262,
+	RO: 40,
+	RU: 7,
+	RW: 250,
+	BL: 590,
+	SH: 290,
+	KN: 1,
+	LC: 1,
+	MF: 590,
+	PM: 508,
+	VC: 1,
+	WS: 685,
+	SM: 378,
+	ST: 239,
+	SA: 966,
+	SN: 221,
+	RS: 381,
+	SC: 248,
+	SL: 232,
+	SG: 65,
+	SX: 1,
+	SK: 421,
+	SI: 386,
+	SB: 677,
+	SO: 252,
+	ZA: 27,
+	GS: 500,
+	SS: 211,
+	ES: 34,
+	LK: 94,
+	SD: 249,
+	SR: 597,
+	SJ: 47,
+	SZ: 268,
+	SE: 46,
+	CH: 41,
+	SY: 963,
+	TW: 886,
+	TJ: 992,
+	TZ: 255,
+	TH: 66,
+	TL: 670,
+	TG: 228,
+	TK: 690,
+	TO: 676,
+	TT: 1,
+	TN: 216,
+	TR: 90,
+	TM: 993,
+	TC: 1,
+	TV: 688,
+	UG: 256,
+	UA: 380,
+	AE: 971,
+	GB: 44,
+	US: 1,
+	UY: 598,
+	UZ: 998,
+	VU: 678,
+	VE: 58,
+	VN: 84,
+	VG: 1,
+	VI: 1,
+	WF: 681,
+	EH: 212,
+	YE: 967,
+	ZM: 260,
+	ZW: 263,
+	XK: 383, // assigned in 2016
+}
+
+// countryPrimaryLanguage maps countries to their dominant
+// official language. Countries with multiple official languages
+// are mapped to the language spoken by the majority
+// or the most common language in business documents.
+// Uninhabited territories like AQ, BV, and HM are not included.
+var countryPrimaryLanguage = map[Code]language.Code{
+	AF: language.PS,
+	AX: language.SV,
+	AL: language.SQ,
+	DZ: language.AR,
+	AS: language.EN,
+	AD: language.CA,
+	AO: language.PT,
+	AI: language.EN,
+	AG: language.EN,
+	AR: language.ES,
+	AM: language.HY,
+	AW: language.NL,
+	AU: language.EN,
+	AT: language.DE,
+	AZ: language.AZ,
+	BS: language.EN,
+	BH: language.AR,
+	BD: language.BN,
+	BB: language.EN,
+	BY: language.BE,
+	BE: language.NL, // Dutch speaking majority, French and German are also official
+	BZ: language.EN,
+	BJ: language.FR,
+	BM: language.EN,
+	BT: language.DZ,
+	BO: language.ES,
+	BQ: language.NL,
+	BA: language.BS,
+	BW: language.EN,
+	BR: language.PT,
+	IO: language.EN,
+	BN: language.MS,
+	BG: language.BG,
+	BF: language.FR,
+	BI: language.RN,
+	KH: language.KM,
+	CM: language.FR,
+	CA: language.EN, // French is also official
+	CV: language.PT,
+	KY: language.EN,
+	CF: language.FR,
+	TD: language.FR,
+	CL: language.ES,
+	CN: language.ZH,
+	CX: language.EN,
+	CC: language.EN,
+	CO: language.ES,
+	KM: language.FR,
+	CG: language.FR,
+	CD: language.FR,
+	CK: language.EN,
+	CR: language.ES,
+	CI: language.FR,
+	HR: language.HR,
+	CU: language.ES,
+	CW: language.NL,
+	CY: language.EL, // Turkish is also official
+	CZ: language.CS,
+	DK: language.DA,
+	DJ: language.FR,
+	DM: language.EN,
+	DO: language.ES,
+	EC: language.ES,
+	EG: language.AR,
+	SV: language.ES,
+	GQ: language.ES,
+	ER: language.TI,
+	EE: language.ET,
+	ET: language.AM,
+	FK: language.EN,
+	FO: language.FO,
+	FJ: language.EN,
+	FI: language.FI, // Swedish is also official
+	FR: language.FR,
+	GF: language.FR,
+	PF: language.FR,
+	TF: language.FR,
+	GA: language.FR,
+	GM: language.EN,
+	GE: language.KA,
+	DE: language.DE,
+	GH: language.EN,
+	GI: language.EN,
+	GR: language.EL,
+	EL: language.EL,
+	GL: language.KL,
+	GD: language.EN,
+	GP: language.FR,
+	GU: language.EN,
+	GT: language.ES,
+	GG: language.EN,
+	GN: language.FR,
+	GW: language.PT,
+	GY: language.EN,
+	HT: language.FR,
+	VA: language.IT,
+	HN: language.ES,
+	HK: language.ZH,
+	HU: language.HU,
+	IS: language.IS,
+	IN: language.HI, // English is also official
+	ID: language.ID,
+	IR: language.FA,
+	IQ: language.AR,
+	IE: language.EN, // Irish is the first official language, but English is dominant
+	IM: language.EN,
+	IL: language.HE,
+	IT: language.IT,
+	JM: language.EN,
+	JP: language.JA,
+	JE: language.EN,
+	JO: language.AR,
+	KZ: language.KK,
+	KE: language.SW,
+	KI: language.EN,
+	KP: language.KO,
+	KR: language.KO,
+	KW: language.AR,
+	KG: language.KY,
+	LA: language.LO,
+	LV: language.LV,
+	LB: language.AR,
+	LS: language.ST,
+	LR: language.EN,
+	LY: language.AR,
+	LI: language.DE,
+	LT: language.LT,
+	LU: language.LB, // Luxembourgish, French and German are also official
+	MO: language.ZH,
+	MK: language.MK,
+	MG: language.MG,
+	MW: language.EN,
+	MY: language.MS,
+	MV: language.DV,
+	ML: language.FR,
+	MT: language.MT,
+	MH: language.MH,
+	MQ: language.FR,
+	MR: language.AR,
+	MU: language.EN,
+	YT: language.FR,
+	MX: language.ES,
+	FM: language.EN,
+	MD: language.RO,
+	MC: language.FR,
+	MN: language.MN,
+	ME: language.SR,
+	MS: language.EN,
+	MA: language.AR,
+	MZ: language.PT,
+	MM: language.MY,
+	NA: language.EN,
+	NR: language.NA,
+	NP: language.NE,
+	NL: language.NL,
+	NC: language.FR,
+	NZ: language.EN,
+	NI: language.ES,
+	NE: language.FR,
+	NG: language.EN,
+	NU: language.EN,
+	NF: language.EN,
+	MP: language.EN,
+	NO: language.NO,
+	OM: language.AR,
+	PK: language.UR,
+	PW: language.EN,
+	PS: language.AR,
+	PA: language.ES,
+	PG: language.EN,
+	PY: language.ES,
+	PE: language.ES,
+	PH: language.TL,
+	PN: language.EN,
+	PL: language.PL,
+	PT: language.PT,
+	PR: language.ES,
+	QA: language.AR,
+	RE: language.FR,
+	RO: language.RO,
+	RU: language.RU,
+	RW: language.RW,
+	BL: language.FR,
+	SH: language.EN,
+	KN: language.EN,
+	LC: language.EN,
+	MF: language.FR,
+	PM: language.FR,
+	VC: language.EN,
+	WS: language.SM,
+	SM: language.IT,
+	ST: language.PT,
+	SA: language.AR,
+	SN: language.FR,
+	RS: language.SR,
+	SC: language.EN,
+	SL: language.EN,
+	SG: language.EN,
+	SX: language.NL,
+	SK: language.SK,
+	SI: language.SL,
+	SB: language.EN,
+	SO: language.SO,
+	ZA: language.EN, // English as lingua franca of eleven official languages
+	GS: language.EN,
+	SS: language.EN,
+	ES: language.ES,
+	LK: language.SI,
+	SD: language.AR,
+	SR: language.NL,
+	SJ: language.NO,
+	SZ: language.EN,
+	SE: language.SV,
+	CH: language.DE, // German speaking majority, French, Italian, and Romansh are also official
+	SY: language.AR,
+	TW: language.ZH,
+	TJ: language.TG,
+	TZ: language.SW,
+	TH: language.TH,
+	TL: language.PT,
+	TG: language.FR,
+	TK: language.EN,
+	TO: language.TO,
+	TT: language.EN,
+	TN: language.AR,
+	TR: language.TR,
+	TM: language.TK,
+	TC: language.EN,
+	TV: language.EN,
+	UG: language.EN,
+	UA: language.UK,
+	AE: language.AR,
+	GB: language.EN,
+	US: language.EN,
+	UM: language.EN,
+	UY: language.ES,
+	UZ: language.UZ,
+	VU: language.BI,
+	VE: language.ES,
+	VN: language.VI,
+	VG: language.EN,
+	VI: language.EN,
+	WF: language.FR,
+	EH: language.AR,
+	YE: language.AR,
+	ZM: language.EN,
+	ZW: language.EN,
+	XK: language.SQ, // Serbian is also official
+}
+
+// numericCodes maps countries to their
+// ISO 3166-1 numeric codes.
+var numericCodes = map[Code]int{
+	AF: 4,
+	AX: 248,
+	AL: 8,
+	DZ: 12,
+	AS: 16,
+	AD: 20,
+	AO: 24,
+	AI: 660,
+	AQ: 10,
+	AG: 28,
+	AR: 32,
+	AM: 51,
+	AW: 533,
+	AU: 36,
+	AT: 40,
+	AZ: 31,
+	BS: 44,
+	BH: 48,
+	BD: 50,
+	BB: 52,
+	BY: 112,
+	BE: 56,
+	BZ: 84,
+	BJ: 204,
+	BM: 60,
+	BT: 64,
+	BO: 68,
+	BQ: 535,
+	BA: 70,
+	BW: 72,
+	BV: 74,
+	BR: 76,
+	IO: 86,
+	BN: 96,
+	BG: 100,
+	BF: 854,
+	BI: 108,
+	KH: 116,
+	CM: 120,
+	CA: 124,
+	CV: 132,
+	KY: 136,
+	CF: 140,
+	TD: 148,
+	CL: 152,
+	CN: 156,
+	CX: 162,
+	CC: 166,
+	CO: 170,
+	KM: 174,
+	CG: 178,
+	CD: 180,
+	CK: 184,
+	CR: 188,
+	CI: 384,
+	HR: 191,
+	CU: 192,
+	CW: 531,
+	CY: 196,
+	CZ: 203,
+	DK: 208,
+	DJ: 262,
+	DM: 212,
+	DO: 214,
+	EC: 218,
+	EG: 818,
+	SV: 222,
+	GQ: 226,
+	ER: 232,
+	EE: 233,
+	ET: 231,
+	FK: 238,
+	FO: 234,
+	FJ: 242,
+	FI: 246,
+	FR: 250,
+	GF: 254,
+	PF: 258,
+	TF: 260,
+	GA: 266,
+	GM: 270,
+	GE: 268,
+	DE: 276,
+	GH: 288,
+	GI: 292,
+	GR: 300,
+	EL: 300, // same as GR
+	GL: 304,
+	GD: 308,
+	GP: 312,
+	GU: 316,
+	GT: 320,
+	GG: 831,
+	GN: 324,
+	GW: 624,
+	GY: 328,
+	HT: 332,
+	HM: 334,
+	VA: 336,
+	HN: 340,
+	HK: 344,
+	HU: 348,
+	IS: 352,
+	IN: 356,
+	ID: 360,
+	IR: 364,
+	IQ: 368,
+	IE: 372,
+	IM: 833,
+	IL: 376,
+	IT: 380,
+	JM: 388,
+	JP: 392,
+	JE: 832,
+	JO: 400,
+	KZ: 398,
+	KE: 404,
+	KI: 296,
+	KP: 408,
+	KR: 410,
+	KW: 414,
+	KG: 417,
+	LA: 418,
+	LV: 428,
+	LB: 422,
+	LS: 426,
+	LR: 430,
+	LY: 434,
+	LI: 438,
+	LT: 440,
+	LU: 442,
+	MO: 446,
+	MK: 807,
+	MG: 450,
+	MW: 454,
+	MY: 458,
+	MV: 462,
+	ML: 466,
+	MT: 470,
+	MH: 584,
+	MQ: 474,
+	MR: 478,
+	MU: 480,
+	YT: 175,
+	MX: 484,
+	FM: 583,
+	MD: 498,
+	MC: 492,
+	MN: 496,
+	ME: 499,
+	MS: 500,
+	MA: 504,
+	MZ: 508,
+	MM: 104,
+	NA: 516,
+	NR: 520,
+	NP: 524,
+	NL: 528,
+	NC: 540,
+	NZ: 554,
+	NI: 558,
+	NE: 562,
+	NG: 566,
+	NU: 570,
+	NF: 574,
+	MP: 580,
+	NO: 578,
+	OM: 512,
+	PK: 586,
+	PW: 585,
+	PS: 275,
+	PA: 591,
+	PG: 598,
+	PY: 600,
+	PE: 604,
+	PH: 608,
+	PN: 612,
+	PL: 616,
+	PT: 620,
+	PR: 630,
+	QA: 634,
+	RE: 638,
+	RO: 642,
+	RU: 643,
+	RW: 646,
+	BL: 652,
+	SH: 654,
+	KN: 659,
+	LC: 662,
+	MF: 663,
+	PM: 666,
+	VC: 670,
+	WS: 882,
+	SM: 674,
+	ST: 678,
+	SA: 682,
+	SN: 686,
+	RS: 688,
+	SC: 690,
+	SL: 694,
+	SG: 702,
+	SX: 534,
+	SK: 703,
+	SI: 705,
+	SB: 90,
+	SO: 706,
+	ZA: 710,
+	GS: 239,
+	SS: 728,
+	ES: 724,
+	LK: 144,
+	SD: 729,
+	SR: 740,
+	SJ: 744,
+	SZ: 748,
+	SE: 752,
+	CH: 756,
+	SY: 760,
+	TW: 158,
+	TJ: 762,
+	TZ: 834,
+	TH: 764,
+	TL: 626,
+	TG: 768,
+	TK: 772,
+	TO: 776,
+	TT: 780,
+	TN: 788,
+	TR: 792,
+	TM: 795,
+	TC: 796,
+	TV: 798,
+	UG: 800,
+	UA: 804,
+	AE: 784,
+	GB: 826,
+	US: 840,
+	UM: 581,
+	UY: 858,
+	UZ: 860,
+	VU: 548,
+	VE: 862,
+	VN: 704,
+	VG: 92,
+	VI: 850,
+	WF: 876,
+	EH: 732,
+	YE: 887,
+	ZM: 894,
+	ZW: 716,
+	XK: 383, // unofficial, but commonly used
+}
+
+// alpha3Codes maps countries to their
+// ISO 3166-1 alpha-3 codes.
+var alpha3Codes = map[Code]string{
+	AF: "AFG",
+	AX: "ALA",
+	AL: "ALB",
+	DZ: "DZA",
+	AS: "ASM",
+	AD: "AND",
+	AO: "AGO",
+	AI: "AIA",
+	AQ: "ATA",
+	AG: "ATG",
+	AR: "ARG",
+	AM: "ARM",
+	AW: "ABW",
+	AU: "AUS",
+	AT: "AUT",
+	AZ: "AZE",
+	BS: "BHS",
+	BH: "BHR",
+	BD: "BGD",
+	BB: "BRB",
+	BY: "BLR",
+	BE: "BEL",
+	BZ: "BLZ",
+	BJ: "BEN",
+	BM: "BMU",
+	BT: "BTN",
+	BO: "BOL",
+	BQ: "BES",
+	BA: "BIH",
+	BW: "BWA",
+	BV: "BVT",
+	BR: "BRA",
+	IO: "IOT",
+	BN: "BRN",
+	BG: "BGR",
+	BF: "BFA",
+	BI: "BDI",
+	KH: "KHM",
+	CM: "CMR",
+	CA: "CAN",
+	CV: "CPV",
+	KY: "CYM",
+	CF: "CAF",
+	TD: "TCD",
+	CL: "CHL",
+	CN: "CHN",
+	CX: "CXR",
+	CC: "CCK",
+	CO: "COL",
+	KM: "COM",
+	CG: "COG",
+	CD: "COD",
+	CK: "COK",
+	CR: "CRI",
+	CI: "CIV",
+	HR: "HRV",
+	CU: "CUB",
+	CW: "CUW",
+	CY: "CYP",
+	CZ: "CZE",
+	DK: "DNK",
+	DJ: "DJI",
+	DM: "DMA",
+	DO: "DOM",
+	EC: "ECU",
+	EG: "EGY",
+	SV: "SLV",
+	GQ: "GNQ",
+	ER: "ERI",
+	EE: "EST",
+	ET: "ETH",
+	FK: "FLK",
+	FO: "FRO",
+	FJ: "FJI",
+	FI: "FIN",
+	FR: "FRA",
+	GF: "GUF",
+	PF: "PYF",
+	TF: "ATF",
+	GA: "GAB",
+	GM: "GMB",
+	GE: "GEO",
+	DE: "DEU",
+	GH: "GHA",
+	GI: "GIB",
+	GR: "GRC",
+	EL: "GRC", // same as GR
+	GL: "GRL",
+	GD: "GRD",
+	GP: "GLP",
+	GU: "GUM",
+	GT: "GTM",
+	GG: "GGY",
+	GN: "GIN",
+	GW: "GNB",
+	GY: "GUY",
+	HT: "HTI",
+	HM: "HMD",
+	VA: "VAT",
+	HN: "HND",
+	HK: "HKG",
+	HU: "HUN",
+	IS: "ISL",
+	IN: "IND",
+	ID: "IDN",
+	IR: "IRN",
+	IQ: "IRQ",
+	IE: "IRL",
+	IM: "IMN",
+	IL: "ISR",
+	IT: "ITA",
+	JM: "JAM",
+	JP: "JPN",
+	JE: "JEY",
+	JO: "JOR",
+	KZ: "KAZ",
+	KE: "KEN",
+	KI: "KIR",
+	KP: "PRK",
+	KR: "KOR",
+	KW: "KWT",
+	KG: "KGZ",
+	LA: "LAO",
+	LV: "LVA",
+	LB: "LBN",
+	LS: "LSO",
+	LR: "LBR",
+	LY: "LBY",
+	LI: "LIE",
+	LT: "LTU",
+	LU: "LUX",
+	MO: "MAC",
+	MK: "MKD",
+	MG: "MDG",
+	MW: "MWI",
+	MY: "MYS",
+	MV: "MDV",
+	ML: "MLI",
+	MT: "MLT",
+	MH: "MHL",
+	MQ: "MTQ",
+	MR: "MRT",
+	MU: "MUS",
+	YT: "MYT",
+	MX: "MEX",
+	FM: "FSM",
+	MD: "MDA",
+	MC: "MCO",
+	MN: "MNG",
+	ME: "MNE",
+	MS: "MSR",
+	MA: "MAR",
+	MZ: "MOZ",
+	MM: "MMR",
+	NA: "NAM",
+	NR: "NRU",
+	NP: "NPL",
+	NL: "NLD",
+	NC: "NCL",
+	NZ: "NZL",
+	NI: "NIC",
+	NE: "NER",
+	NG: "NGA",
+	NU: "NIU",
+	NF: "NFK",
+	MP: "MNP",
+	NO: "NOR",
+	OM: "OMN",
+	PK: "PAK",
+	PW: "PLW",
+	PS: "PSE",
+	PA: "PAN",
+	PG: "PNG",
+	PY: "PRY",
+	PE: "PER",
+	PH: "PHL",
+	PN: "PCN",
+	PL: "POL",
+	PT: "PRT",
+	PR: "PRI",
+	QA: "QAT",
+	RE: "REU",
+	RO: "ROU",
+	RU: "RUS",
+	RW: "RWA",
+	BL: "BLM",
+	SH: "SHN",
+	KN: "KNA",
+	LC: "LCA",
+	MF: "MAF",
+	PM: "SPM",
+	VC: "VCT",
+	WS: "WSM",
+	SM: "SMR",
+	ST: "STP",
+	SA: "SAU",
+	SN: "SEN",
+	RS: "SRB",
+	SC: "SYC",
+	SL: "SLE",
+	SG: "SGP",
+	SX: "SXM",
+	SK: "SVK",
+	SI: "SVN",
+	SB: "SLB",
+	SO: "SOM",
+	ZA: "ZAF",
+	GS: "SGS",
+	SS: "SSD",
+	ES: "ESP",
+	LK: "LKA",
+	SD: "SDN",
+	SR: "SUR",
+	SJ: "SJM",
+	SZ: "SWZ",
+	SE: "SWE",
+	CH: "CHE",
+	SY: "SYR",
+	TW: "TWN",
+	TJ: "TJK",
+	TZ: "TZA",
+	TH: "THA",
+	TL: "TLS",
+	TG: "TGO",
+	TK: "TKL",
+	TO: "TON",
+	TT: "TTO",
+	TN: "TUN",
+	TR: "TUR",
+	TM: "TKM",
+	TC: "TCA",
+	TV: "TUV",
+	UG: "UGA",
+	UA: "UKR",
+	AE: "ARE",
+	GB: "GBR",
+	US: "USA",
+	UM: "UMI",
+	UY: "URY",
+	UZ: "UZB",
+	VU: "VUT",
+	VE: "VEN",
+	VN: "VNM",
+	VG: "VGB",
+	VI: "VIR",
+	WF: "WLF",
+	EH: "ESH",
+	YE: "YEM",
+	ZM: "ZMB",
+	ZW: "ZWE",
+	XK: "XKX", // unofficial, but commonly used
+}
+
+// alpha3ToAlpha2 is the reverse mapping of alpha3Codes
+var alpha3ToAlpha2 = make(map[string]Code, len(alpha3Codes))
+
+// numericToCode is the reverse mapping of numericCodes
+var numericToCode = make(map[int]Code, len(numericCodes))
+
+func init() {
+	for code, num := range numericCodes {
+		if code == EL {
+			continue // GR is the ISO 3166-1 code for 300
+		}
+		numericToCode[num] = code
+	}
+	for code, alpha3 := range alpha3Codes {
+		if code == EL {
+			continue // GR is the ISO 3166-1 code for GRC
+		}
+		alpha3ToAlpha2[alpha3] = code
+	}
+}
@@ -0,0 +1,126 @@
+package country
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/domonda/go-types/language"
+	"github.com/domonda/go-types/strutil"
+)
+
+// MinFuzzyNameLength is the minimum number of characters
+// of a name to be matched by CodeFromNameFuzzy.
+const MinFuzzyNameLength = 4
+
+// countryNames returns the country name maps
+// for the passed languages or for all supported
+// languages if no languages are passed.
+func countryNames(lang []language.Code) []map[Code]string {
+	if len(lang) == 0 {
+		return []map[Code]string{countryMap, germanNames}
+	}
+	var names []map[Code]string
+	for _, l := range lang {
+		switch l, _ := l.Normalized(); l {
+		case language.EN:
+			names = append(names, countryMap)
+		case language.DE:
+			names = append(names, germanNames)
+		}
+	}
+	return names
+}
+
+// CodeFromNameFuzzy returns the Code of the country
+// with the name that has the smallest case insensitive
+// Levenshtein distance to s if that distance
+// is not greater than maxDistance.
+//
+// The names of the passed languages are used,
+// or English and German names if no languages are passed.
+//
+// To avoid false positives, names shorter than MinFuzzyNameLength
+// are never matched and the distance must be smaller
+// than half of the length of s.
+func CodeFromNameFuzzy(s string, maxDistance int, lang ...language.Code) (Code, bool) {
+	s = strings.ToLower(strutil.TrimSpace(s))
+	length := utf8.RuneCountInString(s)
+	if length < MinFuzzyNameLength {
+		return Invalid, false
+	}
+	maxDistance = min(maxDistance, (length-1)/2)
+
+	var (
+		bestCode     Code
+		bestDistance = maxDistance + 1
+	)
+	for _, names := range countryNames(lang) {
+		for code, name := range names {
+			dist := strutil.LevenshteinDistance(s, strings.ToLower(name))
+			// Break ties by code for deterministic results
+			if dist < bestDistance || (dist == bestDistance && code < bestCode) {
+				bestCode = code
+				bestDistance = dist
+			}
+		}
+	}
+	if bestDistance > maxDistance {
+		return Invalid, false
+	}
+	return bestCode, true
+}
+
+// CodeByName returns the Code of the country with
+// the passed English or German name ignoring case,
+// surrounding whitespace, and diacritics,
+// so "OSTERREICH", "österreich", and "Austria" return AT.
+// German umlauts can also be written as "ae", "oe", "ue",
+// and "ß" as "ss", like in "Oesterreich".
+func CodeByName(name string) (Code, bool) {
+	code, ok := codeByFoldedName[foldCountryName(name)]
+	return code, ok
+}
+
+// codeByFoldedName maps the results of foldCountryName
+// and foldCountryNameGerman for all country names to their codes.
+var codeByFoldedName = func() map[string]Code {
+	m := make(map[string]Code)
+	for _, names := range countryNames(nil) {
+		for code, name := range names {
+			// EL has the same name as its ISO code GR
+			if code == EL {
+				continue
+			}
+			m[foldCountryName(name)] = code
+			m[foldCountryNameGerman(name)] = code
+		}
+	}
+	return m
+}()
+
+// foldCountryName returns the trimmed lower case name
+// without diacritics and with ß replaced by ss.
+func foldCountryName(name string) string {
+	name = strings.ToLower(strutil.TrimSpace(name))
+	name = strings.ReplaceAll(name, "ß", "ss")
+	// A transform.Chain is stateful and can't be shared between goroutines
+	removeDiacritics := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(removeDiacritics, name)
+	if err != nil {
+		return name
+	}
+	return folded
+}
+
+// foldCountryNameGerman returns foldCountryName of the name
+// with German umlauts replaced by their two letter transliteration.
+func foldCountryNameGerman(name string) string {
+	return foldCountryName(germanUmlautReplacer.Replace(strings.ToLower(name)))
+}
+
+var germanUmlautReplacer = strings.NewReplacer("ä", "ae", "ö", "oe", "ü", "ue")
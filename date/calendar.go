@@ -0,0 +1,132 @@
+package date
+
+import "time"
+
+// Calendar defines business days as all days
+// that are neither weekend days nor holidays.
+type Calendar struct {
+	// Holidays is the set of normalized holiday dates
+	Holidays map[Date]struct{}
+	// Weekend is indexed by time.Weekday
+	// and true for non business weekdays
+	Weekend [7]bool
+}
+
+// NewCalendar returns a Calendar with the passed holidays
+// and Saturday and Sunday as weekend days.
+// Holidays that can't be normalized are ignored.
+func NewCalendar(holidays ...Date) *Calendar {
+	c := &Calendar{Holidays: make(map[Date]struct{}, len(holidays))}
+	c.Weekend[time.Saturday] = true
+	c.Weekend[time.Sunday] = true
+	for _, holiday := range holidays {
+		c.AddHoliday(holiday)
+	}
+	return c
+}
+
+// AddHoliday adds a holiday to the calendar.
+// Dates that can't be normalized are ignored.
+func (c *Calendar) AddHoliday(holiday Date) {
+	holiday, err := holiday.Normalized()
+	if err != nil {
+		return
+	}
+	if c.Holidays == nil {
+		c.Holidays = make(map[Date]struct{})
+	}
+	c.Holidays[holiday] = struct{}{}
+}
+
+// IsHoliday returns if the date is a holiday of the calendar.
+func (c *Calendar) IsHoliday(date Date) bool {
+	date, err := date.Normalized()
+	if err != nil {
+		return false
+	}
+	_, ok := c.Holidays[date]
+	return ok
+}
+
+// IsBusinessDay returns if the date is neither a weekend day
+// nor a holiday. Returns false for invalid dates.
+func (c *Calendar) IsBusinessDay(date Date) bool {
+	date, err := date.Normalized()
+	if err != nil {
+		return false
+	}
+	if c.Weekend[date.Weekday()] {
+		return false
+	}
+	_, holiday := c.Holidays[date]
+	return !holiday
+}
+
+// AddBusinessDays returns the date that is days business days
+// after the passed date, or before it for negative days,
+// skipping weekend days and holidays.
+// If days is zero, then the normalized date is returned
+// even if it is not a business day.
+// Invalid dates are returned unchanged and an empty
+// string is returned if the calendar has no business weekdays.
+func (c *Calendar) AddBusinessDays(date Date, days int) Date {
+	norm, err := date.Normalized()
+	if err != nil {
+		return date
+	}
+	if days == 0 {
+		return norm
+	}
+	if !c.hasBusinessWeekday() {
+		return ""
+	}
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+	for days > 0 {
+		norm = norm.AddDays(step)
+		if c.IsBusinessDay(norm) {
+			days--
+		}
+	}
+	return norm
+}
+
+// BusinessDaysBetween returns the number of business days
+// after from until and including until.
+// The result is negative if until is before from,
+// so that AddBusinessDays(from, BusinessDaysBetween(from, until))
+// returns until if it is a business day.
+// Returns zero if from or until is not valid.
+func (c *Calendar) BusinessDaysBetween(from, until Date) int {
+	from, err := from.Normalized()
+	if err != nil {
+		return 0
+	}
+	until, err = until.Normalized()
+	if err != nil {
+		return 0
+	}
+	sign := 1
+	if until.Before(from) {
+		sign = -1
+		from, until = until.AddDays(-1), from.AddDays(-1)
+	}
+	count := 0
+	for date := from.AddDays(1); !date.After(until); date = date.AddDays(1) {
+		if c.IsBusinessDay(date) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+func (c *Calendar) hasBusinessWeekday() bool {
+	for _, weekend := range c.Weekend {
+		if !weekend {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package date
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendar_AddBusinessDays(t *testing.T) {
+	cal := NewCalendar()
+	assert.Equal(t, Date("2024-03-11"), cal.AddBusinessDays("2024-03-08", 1), "Friday + 1 is Monday")
+	assert.Equal(t, Date("2024-03-08"), cal.AddBusinessDays("2024-03-11", -1), "Monday - 1 is Friday")
+	assert.Equal(t, Date("2024-03-11"), cal.AddBusinessDays("2024-03-09", 1), "Saturday + 1 is Monday")
+	assert.Equal(t, Date("2024-03-15"), cal.AddBusinessDays("2024-03-08", 5))
+	assert.Equal(t, Date("2024-03-09"), cal.AddBusinessDays("2024-03-09", 0), "zero days returns weekend date")
+	assert.Equal(t, Date("2024-03-11"), cal.AddBusinessDays("11.03.2024", 0), "normalized")
+	assert.Equal(t, Date("invalid"), cal.AddBusinessDays("invalid", 1))
+
+	cal = NewCalendar("2024-03-11")
+	assert.True(t, cal.IsHoliday("2024-03-11"))
+	assert.Equal(t, Date("2024-03-12"), cal.AddBusinessDays("2024-03-08", 1), "holiday on Monday pushes to Tuesday")
+	assert.Equal(t, Date("2024-03-08"), cal.AddBusinessDays("2024-03-12", -1), "backwards over holiday and weekend")
+
+	var noBusinessDays Calendar
+	for i := range noBusinessDays.Weekend {
+		noBusinessDays.Weekend[i] = true
+	}
+	assert.Equal(t, Date(""), noBusinessDays.AddBusinessDays("2024-03-08", 1))
+}
+
+func TestCalendar_IsBusinessDay(t *testing.T) {
+	cal := NewCalendar("2024-12-25")
+	assert.True(t, cal.IsBusinessDay("2024-12-24"))
+	assert.False(t, cal.IsBusinessDay("2024-12-25"), "holiday")
+	assert.False(t, cal.IsBusinessDay("2024-12-28"), "Saturday")
+	assert.False(t, cal.IsBusinessDay("2024-12-29"), "Sunday")
+	assert.False(t, cal.IsBusinessDay(""))
+
+	cal.Weekend[time.Saturday] = false
+	assert.True(t, cal.IsBusinessDay("2024-12-28"), "Saturday without weekend")
+}
+
+func TestCalendar_BusinessDaysBetween(t *testing.T) {
+	cal := NewCalendar("2024-03-11")
+	assert.Equal(t, 0, cal.BusinessDaysBetween("2024-03-08", "2024-03-08"))
+	assert.Equal(t, 1, cal.BusinessDaysBetween("2024-03-08", "2024-03-12"))
+	assert.Equal(t, -1, cal.BusinessDaysBetween("2024-03-12", "2024-03-08"))
+	assert.Equal(t, 4, cal.BusinessDaysBetween("2024-03-08", "2024-03-15"))
+	assert.Equal(t, 0, cal.BusinessDaysBetween("2024-03-08", "invalid"))
+
+	for _, days := range []int{-7, -3, -1, 1, 2, 10} {
+		until := cal.AddBusinessDays("2024-03-06", days)
+		assert.Equal(t, days, cal.BusinessDaysBetween("2024-03-06", until), "days %d", days)
+	}
+}
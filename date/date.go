@@ -2,7 +2,10 @@ package date
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -15,11 +18,42 @@ import (
 )
 
 // Normalize returns str as normalized Date or an error.
+// ISO 8601 ordinal dates like "2023-359" are supported.
 // The first given lang argument is used as language hint.
 func Normalize(str string, lang ...language.Code) (Date, error) {
 	return Date(str).Normalized(lang...)
 }
 
+// AmbiguityPolicy controls how NormalizeWithPolicy resolves
+// numeric dates like "01.02.03" where both the first
+// and the second number could be the month.
+type AmbiguityPolicy int
+
+const (
+	// PreferByLanguage interprets ambiguous dates as month first
+	// for the language hint "en" and else as day first.
+	// This is the policy used by Normalize and Date.Normalized.
+	PreferByLanguage AmbiguityPolicy = iota
+	// PreferDMY interprets ambiguous dates as day first.
+	PreferDMY
+	// PreferMDY interprets ambiguous dates as month first.
+	PreferMDY
+	// ErrorOnAmbiguous returns an error for ambiguous dates.
+	ErrorOnAmbiguous
+)
+
+// NormalizeWithPolicy returns str as normalized Date or an error
+// using policy to resolve if the day or the month comes first
+// in numeric dates where both would be valid like "01.02.03".
+// Dates like "25.12.2023" are not ambiguous because
+// 25 can't be a month and are normalized with every policy.
+// The first given lang argument is used as language hint
+// for month names.
+func NormalizeWithPolicy(str string, policy AmbiguityPolicy, lang ...language.Code) (Date, error) {
+	normalized, _, err := normalizeAndCheckDate(str, getLangHint(lang), policy, true)
+	return normalized, err
+}
+
 // StringIsDate returns if a string can be parsed as Date.
 // The first given lang argument is used as language hint.
 func StringIsDate(str string, lang ...language.Code) bool {
@@ -27,6 +61,14 @@ func StringIsDate(str string, lang ...language.Code) bool {
 	return err == nil
 }
 
+// TwoDigitYearPivot is the pivot used to expand two digit years
+// when parsing dates like "24.12.98".
+// Years below the pivot are expanded to 20xx,
+// all others to 19xx.
+// Changing the pivot affects all date parsing of the package
+// and is not safe while dates are parsed concurrently.
+var TwoDigitYearPivot = 45
+
 const (
 	// Layout used for the Date type, compatible with time.Time.Format()
 	Layout = time.DateOnly
@@ -66,6 +108,25 @@ func Of(year int, month time.Month, day int) Date {
 	return OfTime(time.Date(year, month, day, 0, 0, 0, 0, time.Local))
 }
 
+// OfChecked returns a normalized Date for the given year, month, and day
+// or an error if the month is not within 1 to 12
+// or the day is not within the days of the month.
+// Use Of for lenient construction that normalizes
+// out of range values.
+func OfChecked(year int, month time.Month, day int) (Date, error) {
+	if !validYear(year) || year > 9999 {
+		return "", fmt.Errorf("year %d out of range", year)
+	}
+	if !validMonth(int(month)) {
+		return "", fmt.Errorf("month %d out of range", month)
+	}
+	daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day < 1 || day > daysInMonth {
+		return "", fmt.Errorf("day %d out of range for %04d-%02d", day, year, month)
+	}
+	return Of(year, month, day), nil
+}
+
 // OfTime returns the date part of the passed time.Time
 // or an empty string if t.IsZero().
 // To get the date in a certain time zone,
@@ -120,6 +181,70 @@ func Parse(layout, value string) (Date, error) {
 	return OfTime(t), nil
 }
 
+// ParseLoose returns the date of a Unix epoch timestamp string,
+// an RFC 1123 timestamp with or without numeric zone,
+// or else the result of Normalize.
+//
+// Strings of at least 9 digits are interpreted as epoch timestamps
+// (9 digits cover seconds since March 1973).
+// Values below 1e11 are interpreted as seconds (until the year 5138)
+// and larger values as milliseconds (since March 1973).
+// The date of epoch timestamps is returned in UTC,
+// RFC 1123 dates in the time zone of the timestamp.
+func ParseLoose(s string) (Date, error) {
+	s = strutil.TrimSpace(s)
+	if len(s) >= 9 && isDigits(s) {
+		epoch, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid epoch timestamp %q: %w", s, err)
+		}
+		if epoch < 1e11 {
+			return OfTime(time.Unix(epoch, 0).UTC()), nil
+		}
+		return OfTime(time.UnixMilli(epoch).UTC()), nil
+	}
+	for _, layout := range []string{time.RFC1123, time.RFC1123Z} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return OfTime(t), nil
+		}
+	}
+	return Normalize(s)
+}
+
+// parseAnyLayouts are the time layouts tried by ParseAny
+// in order of priority.
+var parseAnyLayouts = []string{
+	time.RFC3339Nano,
+	time.DateOnly,
+	time.DateTime,
+	time.RFC1123,
+	time.RFC1123Z,
+	time.RFC850,
+	time.ANSIC,
+	"02.01.2006",
+	"2 January 2006",
+	"January 2, 2006",
+	"Jan 2, 2006",
+}
+
+// ParseAny returns the date of the first successful parse of value
+// with the layouts time.RFC3339, time.DateOnly, time.DateTime,
+// time.RFC1123, time.RFC1123Z, time.RFC850, time.ANSIC,
+// the German "02.01.2006", and the English
+// "2 January 2006", "January 2, 2006", and "Jan 2, 2006",
+// or else the result of Normalize with the passed language hints.
+// The date of timestamps with time zone is returned
+// in the time zone of the timestamp.
+func ParseAny(value string, lang ...language.Code) (Date, error) {
+	value = strutil.TrimSpace(value)
+	for _, layout := range parseAnyLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return OfTime(t), nil
+		}
+	}
+	return Normalize(value, lang...)
+}
+
 // PeriodRange returns the dates [from, until] for a period
 // defined in one the following formats:
 // period of a ISO 8601 week of a year: YYYY-Wnn
@@ -136,6 +261,15 @@ func Parse(layout, value string) (Date, error) {
 // Period of year 2018: PeriodRange("2018") == Date("2018-07-01"), Date("2018-12-31"), nil
 // Period of week 1 2019: PeriodRange("2019-W01") == Date("2018-12-31"), Date("2019-01-06"), nil
 func PeriodRange(period string) (from, until Date, err error) {
+	return PeriodRangeWithWeekStart(period, time.Monday)
+}
+
+// PeriodRangeWithWeekStart works like PeriodRange
+// but uses YearWeekRangeWithWeekStart with the passed
+// weekStart for week periods "YYYY-Wnn".
+// Example for US weeks starting on Sunday:
+// PeriodRangeWithWeekStart("2019-W01", time.Sunday) == Date("2018-12-30"), Date("2019-01-05"), nil
+func PeriodRangeWithWeekStart(period string, weekStart time.Weekday) (from, until Date, err error) {
 	if len(period) != 4 && len(period) != 7 && len(period) != 8 {
 		return "", "", fmt.Errorf("invalid period format length: %q", period)
 	}
@@ -165,7 +299,7 @@ func PeriodRange(period string) (from, until Date, err error) {
 		if err != nil || week < 1 || week > 53 {
 			return "", "", fmt.Errorf("invalid period format, can't parse week: %q", period)
 		}
-		from, until = YearWeekRange(year, week)
+		from, until = YearWeekRangeWithWeekStart(year, week, weekStart)
 		return from, until, nil
 
 	case 'Q', 'q':
@@ -197,6 +331,61 @@ func PeriodRange(period string) (from, until Date, err error) {
 	return from, until, nil
 }
 
+// NormalizeAnchor returns the first day of a period
+// notation as accepted by PeriodRange or with the period
+// before the year and any of the separators space, '-', '/', or '.'
+// like "Q2/2023", "H1 2023", "W02-2023", "06/2023", or "2023 Q2".
+// Strings that are not period notations are normalized
+// with the result of Normalize.
+// Examples:
+//
+//	NormalizeAnchor("Q2/2023") == Date("2023-04-01"), nil
+//	NormalizeAnchor("2023-W02") == Date("2023-01-09"), nil
+//	NormalizeAnchor("06.2023") == Date("2023-06-01"), nil
+func NormalizeAnchor(str string) (Date, error) {
+	if period, ok := anchorPeriod(str); ok {
+		from, _, err := PeriodRange(period)
+		if err == nil {
+			return from, nil
+		}
+	}
+	return Normalize(str)
+}
+
+// anchorPeriod returns str in the "YYYY-Xn" format
+// of PeriodRange if it consists of a 4 digit year
+// and a quarter, half-year, week, or month part.
+func anchorPeriod(str string) (period string, ok bool) {
+	str = strings.ToUpper(strutil.TrimSpace(str))
+	if len(str) == 4 {
+		return str, true
+	}
+	parts := strings.FieldsFunc(str, isDateSeparatorRune)
+	if len(parts) != 2 {
+		return "", false
+	}
+	year, part := parts[0], parts[1]
+	if len(year) != 4 {
+		year, part = part, year
+	}
+	if len(year) != 4 || len(part) == 0 || len(part) > 3 {
+		return "", false
+	}
+	switch part[0] {
+	case 'Q', 'H':
+		return year + "-" + part, true
+	case 'W':
+		if len(part) == 2 {
+			part = "W0" + part[1:]
+		}
+		return year + "-" + part, true
+	}
+	if len(part) == 1 {
+		part = "0" + part
+	}
+	return year + "-" + part, true
+}
+
 // YearRange returns the date range from
 // first of January to 31st of December of a year.
 func YearRange(year int) (from, until Date) {
@@ -206,11 +395,29 @@ func YearRange(year int) (from, until Date) {
 
 // YearWeekMonday returns the date of Monday of an ISO 8601 week.
 func YearWeekMonday(year, week int) (monday Date) {
+	return YearWeekStart(year, week, time.Monday)
+}
+
+// YearWeekRange returns the dates of Monday and Sunday of an ISO 8601 week.
+func YearWeekRange(year, week int) (monday, sunday Date) {
+	return YearWeekRangeWithWeekStart(year, week, time.Monday)
+}
+
+// YearWeekStart returns the date of the first day
+// of a week for weeks starting with weekStart.
+// For time.Monday the result equals YearWeekMonday,
+// for other weekdays like time.Sunday for US weeks
+// week 1 is the week containing January 1st.
+func YearWeekStart(year, week int, weekStart time.Weekday) Date {
 	// January 1st of the year
 	t := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
 
-	// Go back to Monday of week
-	t = t.AddDate(0, 0, int(time.Monday-t.Weekday()))
+	// Go to the start of the week
+	if weekStart == time.Monday {
+		t = t.AddDate(0, 0, int(time.Monday-t.Weekday()))
+	} else {
+		t = t.AddDate(0, 0, -int((t.Weekday()-weekStart+7)%7))
+	}
 
 	// Add week days
 	t = t.AddDate(0, 0, (week-1)*7)
@@ -218,25 +425,110 @@ func YearWeekMonday(year, week int) (monday Date) {
 	return OfTime(t)
 }
 
-// YearWeekRange returns the dates of Monday and Sunday of an ISO 8601 week.
-func YearWeekRange(year, week int) (monday, sunday Date) {
-	monday = YearWeekMonday(year, week)
-	sunday = monday.AddDays(6)
-	return monday, sunday
+// YearWeekRangeWithWeekStart returns the dates of the first
+// and last day of a week for weeks starting with weekStart.
+// See YearWeekStart for how week 1 is determined.
+func YearWeekRangeWithWeekStart(year, week int, weekStart time.Weekday) (from, until Date) {
+	from = YearWeekStart(year, week, weekStart)
+	until = from.AddDays(6)
+	return from, until
+}
+
+// OfISOWeekday returns the date of the weekday
+// in the ISO 8601 week of an ISO week-numbering year.
+// It is the inverse of Date.ISOWeek and Date.Weekday.
+// Week 1 of an ISO year is the week containing January 4th,
+// so the returned date may be in the previous or next calendar year
+// than isoYear. Week and weekday values outside
+// of their usual ranges are normalized.
+func OfISOWeekday(isoYear, week int, weekday time.Weekday) Date {
+	// January 4th is always in week 1
+	jan4 := time.Date(isoYear, 1, 4, 0, 0, 0, 0, time.UTC)
+	// ISO weekdays start with Monday as 0 and end with Sunday as 6
+	isoWeekday := func(wd time.Weekday) int { return (int(wd) + 6) % 7 }
+	monday := jan4.AddDate(0, 0, -isoWeekday(jan4.Weekday()))
+	return OfTime(monday.AddDate(0, 0, (week-1)*7+isoWeekday(weekday)))
+}
+
+// NormalizeISO returns the calendar Date for ISO 8601
+// ordinal dates like "2023-359" or "2023359"
+// and week dates like "2023-W52-1", "2023W521", or "2023-W52"
+// where a missing weekday means Monday.
+// Other strings like the ordinal date "2023-359"
+// are normalized with the result of Normalize.
+func NormalizeISO(str string) (Date, error) {
+	trimmed := strings.ToUpper(strutil.TrimSpace(str))
+	if len(trimmed) == 7 && isDigits(trimmed) {
+		return ofISOOrdinal(trimmed[:4], trimmed[4:])
+	}
+	if year, weekDay, ok := strings.Cut(trimmed, "W"); ok && len(year) >= 4 {
+		year = strings.TrimSuffix(year, "-")
+		weekDay = strings.ReplaceAll(weekDay, "-", "")
+		if len(year) == 4 && isDigits(year) && (len(weekDay) == 2 || len(weekDay) == 3) && isDigits(weekDay) {
+			return ofISOWeekDate(year, weekDay[:2], weekDay[2:])
+		}
+	}
+	return Normalize(str)
+}
+
+func ofISOOrdinal(yearStr, dayStr string) (Date, error) {
+	year, _ := strconv.Atoi(yearStr)
+	day, _ := strconv.Atoi(dayStr)
+	daysInYear := time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	if day < 1 || day > daysInYear {
+		return "", fmt.Errorf("ISO 8601 ordinal day %d out of range for year %d", day, year)
+	}
+	return Of(year, 1, day), nil
+}
+
+func ofISOWeekDate(yearStr, weekStr, weekdayStr string) (Date, error) {
+	year, _ := strconv.Atoi(yearStr)
+	week, _ := strconv.Atoi(weekStr)
+	isoWeekday := 1
+	if weekdayStr != "" {
+		isoWeekday, _ = strconv.Atoi(weekdayStr)
+	}
+	if isoWeekday < 1 || isoWeekday > 7 {
+		return "", fmt.Errorf("ISO 8601 weekday %d out of range", isoWeekday)
+	}
+	date := OfISOWeekday(year, week, time.Weekday(isoWeekday%7))
+	if y, w := date.ISOWeek(); y != year || w != week {
+		return "", fmt.Errorf("ISO 8601 week %d out of range for year %d", week, year)
+	}
+	return date, nil
+}
+
+func isDigits(str string) bool {
+	for _, r := range str {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
 }
 
 func FromUntilFromYearAndMonths(year, months string) (fromDate, untilDate Date, err error) {
 	if year == "" {
 		return "", "", nil
 	}
-	if months == "" {
-		months = "1-12"
-	}
-	parts := strings.Split(months, "-")
-	fromMonth := parts[0]
-	untilMonth := parts[0]
-	if len(parts) == 2 {
-		untilMonth = parts[1]
+	// A missing month before or after the separator
+	// means an open range to the start or end of the year
+	months = strutil.TrimSpace(months)
+	parts := strutil.SplitAndTrim(months, "-")
+	var fromMonth, untilMonth string
+	switch {
+	case len(parts) == 0:
+		fromMonth, untilMonth = "1", "12"
+	case len(parts) == 2:
+		fromMonth, untilMonth = parts[0], parts[1]
+	case len(parts) > 2 || strings.HasPrefix(months, "-") && strings.HasSuffix(months, "-"):
+		return "", "", fmt.Errorf("invalid month range: %q", months)
+	case strings.HasPrefix(months, "-"):
+		fromMonth, untilMonth = "1", parts[0]
+	case strings.HasSuffix(months, "-"):
+		fromMonth, untilMonth = parts[0], "12"
+	default:
+		fromMonth, untilMonth = parts[0], parts[0]
 	}
 
 	yearInt, err := strconv.Atoi(year)
@@ -279,7 +571,7 @@ func (date *Date) ScanString(source string, validate bool) error {
 }
 
 func (date *Date) ScanStringWithLang(source string, lang language.Code) (wasNormalized bool, monthMustBeFirst bool, err error) {
-	newDate, monthMustBeFirst, err := normalizeAndCheckDate(source, lang)
+	newDate, monthMustBeFirst, err := normalizeAndCheckDate(source, lang, PreferByLanguage, true)
 	if err != nil {
 		return false, false, err
 	}
@@ -502,6 +794,31 @@ func (date Date) Sub(other Date) time.Duration {
 	return date.MidnightUTC().Sub(other.MidnightUTC())
 }
 
+// SubDays returns the number of calendar days between
+// other and date which is negative if other is after date.
+// The calculation uses MidnightUTC so that
+// daylight saving time changes have no effect.
+// Zero is returned if date or other is not valid.
+func (date Date) SubDays(other Date) int {
+	days, _ := date.subDays(other)
+	return days
+}
+
+// subDays returns the calendar days between other and date
+// counted in Unix seconds instead of time.Duration
+// which would saturate beyond about 292 years.
+func (date Date) subDays(other Date) (days int, ok bool) {
+	a, err := date.Normalized()
+	if err != nil {
+		return 0, false
+	}
+	b, err := other.Normalized()
+	if err != nil {
+		return 0, false
+	}
+	return int((a.MidnightUTC().Unix() - b.MidnightUTC().Unix()) / (24 * 60 * 60)), true
+}
+
 func (date Date) BeginningOfWeek() Date {
 	n := (now.Now{Time: date.MidnightUTC()})
 	return OfTime(n.BeginningOfWeek())
@@ -595,6 +912,85 @@ func (date Date) Day() int {
 	return day
 }
 
+// Quarter of the year of the date from 1 to 4
+// or zero if the date is not valid.
+func (date Date) Quarter() int {
+	month := date.Month()
+	if month == 0 {
+		return 0
+	}
+	return (int(month)-1)/3 + 1
+}
+
+// DaysInMonth returns the number of days
+// in the month of the date from 28 to 31
+// or zero if the date is not valid.
+func (date Date) DaysInMonth() int {
+	year, month, _ := date.YearMonthDay()
+	if month == 0 {
+		return 0
+	}
+	return Of(year, month+1, 0).Day()
+}
+
+// IsLeapYear returns if the year of the date is a leap year
+// or false if the date is not valid.
+func (date Date) IsLeapYear() bool {
+	year, month, _ := date.YearMonthDay()
+	if month == 0 {
+		return false
+	}
+	return Of(year, time.March, 0).Day() == 29 // last day of February
+}
+
+// YearQuarter returns the YearQuarter of the date
+// or an empty string if the date is not valid.
+func (date Date) YearQuarter() YearQuarter {
+	quarter := date.Quarter()
+	if quarter == 0 {
+		return ""
+	}
+	return YearQuarterFrom(date.Year(), quarter)
+}
+
+// WithYear returns the date with the year replaced.
+// Like Of, out of range days are normalized,
+// so February 29 of a leap year converts
+// to March 1 of a non leap year.
+// An invalid date is returned unchanged.
+func (date Date) WithYear(year int) Date {
+	_, month, day := date.YearMonthDay()
+	if day == 0 {
+		return date
+	}
+	return Of(year, month, day)
+}
+
+// WithMonth returns the date with the month replaced.
+// Like Of, out of range values are normalized,
+// so setting February for the 31st of a month
+// converts to the beginning of March.
+// An invalid date is returned unchanged.
+func (date Date) WithMonth(month time.Month) Date {
+	year, _, day := date.YearMonthDay()
+	if day == 0 {
+		return date
+	}
+	return Of(year, month, day)
+}
+
+// WithDay returns the date with the day within the month replaced.
+// Like Of, out of range values are normalized,
+// so day 32 converts to a day of the next month.
+// An invalid date is returned unchanged.
+func (date Date) WithDay(day int) Date {
+	year, month, d := date.YearMonthDay()
+	if d == 0 {
+		return date
+	}
+	return Of(year, month, day)
+}
+
 // Weekday returns the date's day of the week.
 func (date Date) Weekday() time.Weekday {
 	t := date.Midnight()
@@ -642,6 +1038,10 @@ func (date Date) BeforeTodayInUTC() bool {
 }
 
 // Scan implements the database/sql.Scanner interface.
+// Supported value types are string, []byte, time.Time,
+// int64 as Unix seconds interpreted in UTC, and nil.
+// Plain int values are rejected because database/sql
+// drivers return all integers as int64.
 func (date *Date) Scan(value any) (err error) {
 	switch x := value.(type) {
 	case string:
@@ -655,10 +1055,17 @@ func (date *Date) Scan(value any) (err error) {
 		*date = d
 		return nil
 
+	case []byte:
+		return date.Scan(string(x))
+
 	case time.Time:
 		*date = OfTime(x)
 		return nil
 
+	case int64:
+		*date = OfTime(time.Unix(x, 0).UTC())
+		return nil
+
 	case nil:
 		*date = ""
 		return nil
@@ -679,6 +1086,97 @@ func (date Date) Value() (driver.Value, error) {
 	return string(normalized), nil
 }
 
+// binaryZero is the MarshalBinary encoding of a zero Date
+const binaryZero = math.MinInt32
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface
+// by encoding the date as little-endian int32 of the days since 1970-01-01.
+// A zero date (see IsZero) is encoded as math.MinInt32.
+// Returns an error for a non zero date that is not valid.
+func (date Date) MarshalBinary() ([]byte, error) {
+	days := int64(binaryZero)
+	if !date.IsZero() {
+		norm, err := date.Normalized()
+		if err != nil {
+			return nil, err
+		}
+		days = norm.MidnightUTC().Unix() / (24 * 60 * 60)
+		if days <= math.MinInt32 || days > math.MaxInt32 {
+			return nil, fmt.Errorf("date.Date %q out of range for binary encoding", date)
+		}
+	}
+	return binary.LittleEndian.AppendUint32(nil, uint32(int32(days))), nil //#nosec G115 -- range checked
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface
+// by decoding the 4 byte encoding of MarshalBinary.
+func (date *Date) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return fmt.Errorf("can't unmarshal %d bytes as date.Date, expected 4", len(data))
+	}
+	days := int32(binary.LittleEndian.Uint32(data)) //#nosec G115 -- two's complement reinterpretation
+	if days == binaryZero {
+		*date = ""
+		return nil
+	}
+	*date = OfTime(time.Unix(int64(days)*24*60*60, 0).UTC())
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+// by returning the normalized date or empty text for a zero date.
+// An error is returned for a non empty invalid date.
+func (date Date) MarshalText() ([]byte, error) {
+	if date.IsZero() {
+		return []byte{}, nil
+	}
+	normalized, err := date.Normalized()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(normalized), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+// by normalizing the text. Empty text is unmarshalled as empty Date.
+// An error is returned for text that can't be normalized.
+func (date *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*date = ""
+		return nil
+	}
+	normalized, err := Date(text).Normalized()
+	if err != nil {
+		return err
+	}
+	*date = normalized
+	return nil
+}
+
+// MarshalJSON implements encoding/json.Marshaler
+// by returning the date unchanged as JSON string
+// so that MarshalText is not used for JSON.
+func (date Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(date))
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler
+// by setting the date unchanged from a JSON string
+// without validation so that UnmarshalText is not used for JSON.
+// The JSON null value is ignored like for any string type.
+func (date *Date) UnmarshalJSON(sourceJSON []byte) error {
+	if string(sourceJSON) == "null" {
+		return nil
+	}
+	var s string
+	err := json.Unmarshal(sourceJSON, &s)
+	if err != nil {
+		return fmt.Errorf("can't unmarshal JSON %s as date.Date: %w", sourceJSON, err)
+	}
+	*date = Date(s)
+	return nil
+}
+
 func isDateSeparatorRune(r rune) bool {
 	return unicode.IsSpace(r) || r == '.' || r == '/' || r == '-'
 }
@@ -691,12 +1189,17 @@ func isDateTrimRune(r rune) bool {
 // or an error if the format can't be detected.
 // The first given lang argument is used as language hint.
 func (date Date) Normalized(lang ...language.Code) (Date, error) {
-	normalized, _, err := normalizeAndCheckDate(string(date), getLangHint(lang))
+	normalized, _, err := normalizeAndCheckDate(string(date), getLangHint(lang), PreferByLanguage, true)
 	return normalized, err
 }
 
-func normalizeAndCheckDate(str string, langHint language.Code) (Date, bool, error) {
-	normalized, monthMustBeFirst, err := normalizeDate(str, langHint)
+// normalizeAndCheckDate normalizes str and checks that the result
+// is a valid date. If numberForms is true then forms that can't be
+// distinguished from other numbers, like "20231225" without separators
+// or the ISO 8601 ordinal date "2023-359", are also accepted,
+// else they are rejected like when finding dates in texts.
+func normalizeAndCheckDate(str string, langHint language.Code, policy AmbiguityPolicy, numberForms bool) (Date, bool, error) {
+	normalized, monthMustBeFirst, err := normalizeDate(str, langHint, policy, numberForms)
 	if err != nil {
 		return "", monthMustBeFirst, err
 	}
@@ -707,16 +1210,19 @@ func normalizeAndCheckDate(str string, langHint language.Code) (Date, bool, erro
 	return Date(normalized), monthMustBeFirst, nil
 }
 
-func normalizeDate(str string, langHint language.Code) (string, bool, error) {
+func normalizeDate(str string, langHint language.Code, policy AmbiguityPolicy, numberForms bool) (string, bool, error) {
 	trimmed := strings.TrimSuffix(str, "00:00:00") // Trim zero time part
 	trimmed = strings.TrimFunc(trimmed, isDateTrimRune)
 	if len(trimmed) < MinLength {
 		return "", false, fmt.Errorf("too short for a date: %q", str)
 	}
 
-	if len(trimmed) > 10 && trimmed[10] == 'T' {
+	if len(trimmed) > 10 && (trimmed[10] == 'T' || trimmed[10] == 't') {
 		// Use date part of this date-time format: "2006-01-02T15:04:05"
 		trimmed = trimmed[:10]
+	} else if len(trimmed) > 13 && trimmed[10] == ' ' && trimmed[13] == ':' && trimmed[4] == '-' && trimmed[7] == '-' {
+		// Use date part of SQL timestamp format: "2006-01-02 15:04:05"
+		trimmed = trimmed[:10]
 	}
 
 	trimmed = strings.ToLower(trimmed)
@@ -731,9 +1237,25 @@ func normalizeDate(str string, langHint language.Code) (string, bool, error) {
 			parts = append(parts[:i], parts[i+1:]...)
 		}
 	}
+	if numberForms && len(parts) == 1 && isDigits(parts[0]) {
+		return normalizeCompactDate(parts[0], str)
+	}
+	if numberForms && len(trimmed) == 8 && trimmed[4] == '-' && isDigits(trimmed[:4]) && isDigits(trimmed[5:]) {
+		// ISO 8601 ordinal date "YYYY-DDD"
+		date, err := ofISOOrdinal(trimmed[:4], trimmed[5:])
+		if err != nil {
+			return "", false, fmt.Errorf("invalid ordinal date %q: %w", str, err)
+		}
+		return string(date), false, nil
+	}
 	if len(parts) != 3 {
 		return "", false, fmt.Errorf("date must have 3 parts: %q", str)
 	}
+	for i := range parts {
+		// Remove comma after day like in "September 17, 2012"
+		// and apostrophe of two digit years like in "May 7, '70"
+		parts[i] = strings.TrimPrefix(strings.TrimSuffix(parts[i], ","), "'")
+	}
 	dayHint := -1
 	totalLen := 0
 	for i := range parts {
@@ -741,17 +1263,9 @@ func normalizeDate(str string, langHint language.Code) (string, bool, error) {
 		totalLen += l
 		if l == 1 {
 			parts[i] = "0" + parts[i]
-		} else if parts[i] == "1st" {
-			parts[i] = "01"
-			dayHint = i
-		} else if parts[i] == "2nd" {
-			parts[i] = "02"
-			dayHint = i
-		} else if parts[i] == "3rd" {
-			parts[i] = "03"
-			dayHint = i
-		} else if strings.HasSuffix(parts[i], "th") {
-			parts[i] = strings.TrimSuffix(parts[i], "th")
+		} else if day, ok := trimOrdinalSuffix(parts[i]); ok {
+			// Ordinal day like "1st", "22nd", "23rd", or "17th"
+			parts[i] = day
 			if len(parts[i]) == 1 {
 				parts[i] = "0" + parts[i]
 			}
@@ -768,9 +1282,9 @@ func normalizeDate(str string, langHint language.Code) (string, bool, error) {
 	val0, _ := strconv.Atoi(parts[0])
 	val1, _ := strconv.Atoi(parts[1])
 	val2, _ := strconv.Atoi(parts[2])
-	month0, _ := monthNameMap[parts[0]]
-	month1, _ := monthNameMap[parts[1]]
-	month2, _ := monthNameMap[parts[2]]
+	month0 := monthFromName(parts[0], langHint)
+	month1 := monthFromName(parts[1], langHint)
+	month2 := monthFromName(parts[2], langHint)
 
 	// fmt.Println(len0, len1, len2)
 	// fmt.Println(val0, val1, val2)
@@ -779,7 +1293,7 @@ func normalizeDate(str string, langHint language.Code) (string, bool, error) {
 		if len2 != 2 {
 			panic("len2")
 		}
-		if val2 < 45 {
+		if val2 < TwoDigitYearPivot {
 			parts[2] = "20" + parts[2]
 			val2 = 2000 + val2
 		} else {
@@ -827,7 +1341,7 @@ func normalizeDate(str string, langHint language.Code) (string, bool, error) {
 	case month2 != 0:
 		if len0 == 2 {
 			// YY DD m
-			if val0 < 45 {
+			if val0 < TwoDigitYearPivot {
 				parts[0] = "20" + parts[0]
 				val0 = 2000 + val0
 			} else {
@@ -851,7 +1365,19 @@ func normalizeDate(str string, langHint language.Code) (string, bool, error) {
 
 	case len0 == 2 && len1 == 2 && len2 == 4:
 		monthMustBeFirst := validMonth(val0) && !validMonth(val1)
-		if (!validMonth(val1) && validMonth(val0)) || dayHint == 1 || langHint == "en" {
+		ambiguous := validMonth(val0) && validMonth(val1) && val0 != val1 && dayHint == -1
+		swap := monthMustBeFirst || dayHint == 1
+		switch policy {
+		case PreferByLanguage:
+			swap = swap || langHint == "en"
+		case PreferMDY:
+			swap = swap || ambiguous
+		case ErrorOnAmbiguous:
+			if ambiguous {
+				return "", false, fmt.Errorf("ambiguous day and month order: %q", str)
+			}
+		}
+		if swap {
 			// MM DD YYYY
 			parts[0], parts[1] = parts[1], parts[0]
 			val0, val1 = val1, val0
@@ -869,6 +1395,40 @@ func normalizeDate(str string, langHint language.Code) (string, bool, error) {
 	return "", false, fmt.Errorf("invalid date: %q", str)
 }
 
+// trimOrdinalSuffix returns the digits of an english
+// ordinal number like "1st", "22nd", "23rd", or "17th".
+func trimOrdinalSuffix(str string) (digits string, ok bool) {
+	for _, suffix := range []string{"st", "nd", "rd", "th"} {
+		if digits, ok := strings.CutSuffix(str, suffix); ok && digits != "" && isDigits(digits) {
+			return digits, true
+		}
+	}
+	return "", false
+}
+
+// normalizeCompactDate normalizes the digits of
+// the compact formats YYYYMMDD and YYYYMM,
+// where YYYYMM means the first day of the month.
+// Only years starting with 19 or 20 are accepted
+// to not misinterpret other formats like DDMMYY.
+func normalizeCompactDate(digits, str string) (string, bool, error) {
+	if (len(digits) != 8 && len(digits) != 6) || (digits[:2] != "19" && digits[:2] != "20") {
+		return "", false, fmt.Errorf("invalid date: %q", str)
+	}
+	month, _ := strconv.Atoi(digits[4:6])
+	if !validMonth(month) {
+		return "", false, fmt.Errorf("invalid date: %q", str)
+	}
+	if len(digits) == 6 {
+		return digits[:4] + "-" + digits[4:6] + "-01", false, nil
+	}
+	day, _ := strconv.Atoi(digits[6:])
+	if !validDay(day) {
+		return "", false, fmt.Errorf("invalid date: %q", str)
+	}
+	return digits[:4] + "-" + digits[4:6] + "-" + digits[6:], false, nil
+}
+
 func validYear(year int) bool {
 	return year > 0
 }
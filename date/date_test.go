@@ -45,21 +45,16 @@ func Test_Normalize(t *testing.T) {
 		"2016 25. März":   "2016-03-25",
 		"75 1st of march": "1975-03-01",
 
-		// TODO
-		// "2 janv. 2019",  // french
-		// "30 janv. 2019", // french
-		// "23/gen/2019",   // italian
-
 		// Test data from https://raw.githubusercontent.com/araddon/dateparse/master/parseany_test.go
-		// "oct 7, 1970":   "1970-10-07", // TODO
-		// "oct 7, '70":    "1970-10-07", // TODO
-		// "Oct 7, '70":    "1970-10-07", // TODO
-		// "Oct. 7, '70":   "1970-10-07", // TODO
-		// "oct. 7, '70":   "1970-10-07", // TODO
-		// "oct. 7, 1970": "1970-10-07", // TODO
-		// "Sept. 7, '70":  "1970-09-07", // TODO
-		// "sept. 7, 1970": "1970-09-07", // TODO
-		// "Feb 8, 2009":      "2009-02-08", // TODO
+		"oct 7, 1970":      "1970-10-07",
+		"oct 7, '70":       "1970-10-07",
+		"Oct 7, '70":       "1970-10-07",
+		"Oct. 7, '70":      "1970-10-07",
+		"oct. 7, '70":      "1970-10-07",
+		"oct. 7, 1970":     "1970-10-07",
+		"Sept. 7, '70":     "1970-09-07",
+		"sept. 7, 1970":    "1970-09-07",
+		"Feb 8, 2009":      "2009-02-08",
 		"7 oct 70":         "1970-10-07",
 		"7 oct 1970":       "1970-10-07",
 		"7 May 1970":       "1970-05-07",
@@ -70,29 +65,29 @@ func Test_Normalize(t *testing.T) {
 		// "Mon Jan 02 2006": "2006-01-02", // TODO
 		// "Thu May 08 2009": "2009-05-08", // TODO
 		// Month dd, yyyy at time
-		// "September 17, 2012": "2012-09-17", // TODO
-		// "May 17, 2012":       "2012-05-17", // TODO
+		"September 17, 2012": "2012-09-17",
+		"May 17, 2012":       "2012-05-17",
 		// Month dd yyyy time
-		// "September 17 2012": "2012-09-17", // TODO
+		"September 17 2012": "2012-09-17",
 		// Month dd, yyyy
-		// "May 7, 2012":  "2012-05-07", // TODO
-		// "June 7, 2012": "2012-06-07", // TODO
-		// "June 7 2012":  "2012-06-07", // TODO
+		"May 7, 2012":  "2012-05-07",
+		"June 7, 2012": "2012-06-07",
+		"June 7 2012":  "2012-06-07",
 		// Month dd[th,nd,st,rd] yyyy
-		// "September 17th, 2012": "2012-09-17", // TODO
-		// "September 17th 2012":  "2012-09-17", // TODO
-		// "September 7th, 2012":  "2012-09-07", // TODO
-		// "September 7th 2012":   "2012-09-07", // TODO
-		// "May 1st 2012":         "2012-05-01", // TODO
-		// "May 1st, 2012":        "2012-05-01", // TODO
-		// "May 21st 2012":        "2012-05-21", // TODO
-		// "May 21st, 2012":       "2012-05-21", // TODO
-		// "May 23rd 2012":        "2012-05-23", // TODO
-		// "May 23rd, 2012":       "2012-05-23", // TODO
-		// "June 2nd, 2012":       "2012-06-02", // TODO
-		// "June 2nd 2012":        "2012-06-02", // TODO
-		// "June 22nd, 2012":      "2012-06-22", // TODO
-		// "June 22nd 2012":       "2012-06-22", // TODO
+		"September 17th, 2012": "2012-09-17",
+		"September 17th 2012":  "2012-09-17",
+		"September 7th, 2012":  "2012-09-07",
+		"September 7th 2012":   "2012-09-07",
+		"May 1st 2012":         "2012-05-01",
+		"May 1st, 2012":        "2012-05-01",
+		"May 21st 2012":        "2012-05-21",
+		"May 21st, 2012":       "2012-05-21",
+		"May 23rd 2012":        "2012-05-23",
+		"May 23rd, 2012":       "2012-05-23",
+		"June 2nd, 2012":       "2012-06-02",
+		"June 2nd 2012":        "2012-06-02",
+		"June 22nd, 2012":      "2012-06-22",
+		"June 22nd 2012":       "2012-06-22",
 		// ?
 		// "Fri, 03 Jul 2015": "2015-07-03", // TODO
 		// "Fri, 3 Jul 2015":  "2015-07-03", // TODO
@@ -162,8 +157,9 @@ func Test_Normalize(t *testing.T) {
 		"08.21.71": "1971-08-21",
 		//  yyyymmdd and similar
 		// "2014":     "2014-01-01", // TODO
-		// "201412":   "2014-12-01", // TODO
-		// "20140601": "2014-06-01", // TODO
+		"201412":   "2014-12-01",
+		"20140601": "2014-06-01",
+		"19991231": "1999-12-31",
 
 		"2006-01-02T15:04:05Z07:00":           "2006-01-02", // RFC3339
 		"2006-01-02T15:04:05.999999999Z07:00": "2006-01-02", // RFC3339Nano
@@ -183,6 +179,17 @@ func Test_Normalize(t *testing.T) {
 		"6/12/6",
 		"6/12/6,",
 		"3:28:00",
+		"September 32, 2012",
+		"February 30th, 2012",
+		"May 0, '70",
+		"250000",
+		"311224",
+		"201413",
+		"20140631",
+		"20141301",
+		"20140600",
+		"30000101",
+		"2014060",
 	}
 
 	for _, invalidDate := range invalidDates {
@@ -193,6 +200,114 @@ func Test_Normalize(t *testing.T) {
 	}
 }
 
+func Test_NormalizeLang(t *testing.T) {
+	tests := []struct {
+		str  string
+		lang language.Code
+		want Date
+	}{
+		{str: "2 janv. 2019", lang: language.FR, want: "2019-01-02"},
+		{str: "30 janv. 2019", lang: language.FR, want: "2019-01-30"},
+		{str: "14 févr. 2020", lang: language.FR, want: "2020-02-14"},
+		{str: "1 août 2021", lang: language.FR, want: "2021-08-01"},
+		{str: "15 mars 2022", lang: language.FR, want: "2022-03-15"},
+		{str: "3 Décembre 2018", lang: language.FR, want: "2018-12-03"},
+		{str: "23/gen/2019", lang: language.IT, want: "2019-01-23"},
+		{str: "5 maggio 2019", lang: language.IT, want: "2019-05-05"},
+		{str: "12 mar 2019", lang: language.IT, want: "2019-03-12"},
+		{str: "12 dic. 2019", lang: language.IT, want: "2019-12-12"},
+		// English and German month names still work with a hint
+		{str: "jan. 24 2012", lang: language.FR, want: "2012-01-24"},
+		{str: "1. Dezember 2016", lang: language.IT, want: "2016-12-01"},
+		// "mar" is March in English but "mardi" (Tuesday) in French
+		{str: "12 mar 2019", lang: language.EN, want: "2019-03-12"},
+		{str: "12 mar 2019", lang: language.FR, want: ""},
+		// Without hint all languages are used
+		{str: "2 janv. 2019", want: "2019-01-02"},
+		{str: "23/gen/2019", want: "2019-01-23"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("Normalize(%s, %s)", tt.str, tt.lang), func(t *testing.T) {
+			got, err := Normalize(tt.str, tt.lang)
+			if tt.want == "" {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	frFinder := NewFinder(language.FR)
+	str := "Facture du 2 janv. 2019, échéance 1 févr. 2019"
+	indices := frFinder.FindAllIndex([]byte(str), -1)
+	if assert.Len(t, indices, 2) {
+		assert.Equal(t, "2 janv. 2019", str[indices[0][0]:indices[0][1]])
+		assert.Equal(t, "1 févr. 2019", str[indices[1][0]:indices[1][1]])
+	}
+}
+
+func TestNormalizeWithPolicy(t *testing.T) {
+	tests := []struct {
+		str    string
+		policy AmbiguityPolicy
+		lang   language.Code
+		want   Date
+	}{
+		{str: "01.02.03", policy: PreferDMY, want: "2003-02-01"},
+		{str: "01.02.03", policy: PreferMDY, want: "2003-01-02"},
+		{str: "01.02.03", policy: ErrorOnAmbiguous, want: ""},
+		{str: "01.02.03", policy: PreferByLanguage, lang: language.DE, want: "2003-02-01"},
+		{str: "01.02.03", policy: PreferByLanguage, lang: language.EN, want: "2003-01-02"},
+		{str: "01.02.03", policy: PreferDMY, lang: language.EN, want: "2003-02-01"},
+		// Not ambiguous
+		{str: "25.12.2023", policy: PreferDMY, want: "2023-12-25"},
+		{str: "25.12.2023", policy: PreferMDY, want: "2023-12-25"},
+		{str: "25.12.2023", policy: ErrorOnAmbiguous, want: "2023-12-25"},
+		{str: "12/25/2023", policy: ErrorOnAmbiguous, want: "2023-12-25"},
+		{str: "03.03.2023", policy: ErrorOnAmbiguous, want: "2023-03-03"},
+		{str: "1st/02/2023", policy: ErrorOnAmbiguous, want: "2023-02-01"},
+		{str: "2023-01-02", policy: ErrorOnAmbiguous, want: "2023-01-02"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("NormalizeWithPolicy(%s, %d, %s)", tt.str, tt.policy, tt.lang), func(t *testing.T) {
+			got, err := NormalizeWithPolicy(tt.str, tt.policy, tt.lang)
+			if tt.want == "" {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTwoDigitYearPivot(t *testing.T) {
+	defer func(pivot int) { TwoDigitYearPivot = pivot }(TwoDigitYearPivot)
+
+	assert.Equal(t, NullableDate("2040-12-24"), Date("24.12.40").NormalizedOrNull())
+	assert.Equal(t, NullableDate("1950-12-24"), Date("24.12.50").NormalizedOrNull())
+
+	TwoDigitYearPivot = 30
+	tests := []struct {
+		str  string
+		want Date
+	}{
+		{str: "24.12.25", want: "2025-12-24"},
+		{str: "24.12.40", want: "1940-12-24"},
+		{str: "24.12.29", want: "2029-12-24"},
+		{str: "24.12.30", want: "1930-12-24"},
+		{str: "24 Dec 25", want: "2025-12-24"},
+		{str: "Dec 24 40", want: "1940-12-24"},
+		{str: "40 24 Dec", want: "1940-12-24"},
+	}
+	for _, tt := range tests {
+		got, err := Normalize(tt.str)
+		assert.NoError(t, err, tt.str)
+		assert.Equal(t, tt.want, got, tt.str)
+	}
+}
+
 func Test_Finder(t *testing.T) {
 
 	deFinderData := map[string][][]int{
@@ -232,6 +347,92 @@ func Test_Finder(t *testing.T) {
 	}
 }
 
+func TestFinder_IgnoresNumbers(t *testing.T) {
+	finder := NewFinder(language.DE)
+	for _, str := range []string{
+		"Rechnung 20191231",
+		"Kundennummer 200312",
+		"Auftrag 199905",
+		"Rechnung 2023-001",
+	} {
+		assert.Empty(t, finder.FindAllIndex([]byte(str), -1), str)
+	}
+	// Normalize still accepts the compact and ordinal forms
+	assert.Equal(t, Date("2019-12-31"), Date("20191231").NormalizedOrNull().Get())
+	assert.Equal(t, Date("2003-12-01"), Date("200312").NormalizedOrNull().Get())
+	assert.Equal(t, Date("2023-01-01"), Date("2023-001").NormalizedOrNull().Get())
+}
+
+func TestNewFinderWithOptions(t *testing.T) {
+	find := func(finder *Finder, str string) (found []string) {
+		for _, indices := range finder.FindAllIndex([]byte(str), -1) {
+			found = append(found, str[indices[0]:indices[1]])
+		}
+		return found
+	}
+	const str = "Rechnung 16.12.98 vom 16.12.1998, Lieferung 16. Dezember 98, fällig 15.01.2024, Vertrag 01.01.2150"
+
+	assert.Equal(t,
+		[]string{"16.12.98", "16.12.1998", "16. Dezember 98", "15.01.2024", "01.01.2150"},
+		find(NewFinder(language.DE), str),
+	)
+	assert.Equal(t,
+		[]string{"16.12.1998", "15.01.2024", "01.01.2150"},
+		find(NewFinderWithOptions(language.DE, FinderOptions{RequireFourDigitYear: true}), str),
+	)
+	assert.Equal(t,
+		[]string{"16.12.1998", "15.01.2024"},
+		find(NewFinderWithOptions(language.DE, FinderOptions{RequireFourDigitYear: true, MinYear: 1900, MaxYear: 2100}), str),
+	)
+	assert.Equal(t,
+		[]string{"15.01.2024", "01.01.2150"},
+		find(NewFinderWithOptions(language.DE, FinderOptions{MinYear: 2000}), str),
+	)
+
+	assert.Equal(t,
+		NewFinder("de-AT").LangHint,
+		NewFinderWithOptions("de-AT", FinderOptions{}).LangHint,
+		"language hint like NewFinder",
+	)
+}
+
+func Test_PeriodFinder(t *testing.T) {
+	finderData := map[string][][]int{
+		"":                              nil,
+		"2023":                          nil,
+		"2023-06":                       {[]int{0, 7}},
+		"Report 2023-Q2 vs 2022-q2":     {[]int{7, 14}, []int{18, 25}},
+		"2023-H1, 2023-W05 and 2023-12": {[]int{0, 7}, []int{9, 17}, []int{22, 29}},
+		"(2023-06)":                     {[]int{1, 8}},
+		"2023-13 2023-Q5 2023-H3":       nil,
+		"2023-W54 2023-W00 2023-00":     nil,
+		"12023-06 2023-065 x2023-06":    nil,
+		"2023-06-15 15.2023-06":         nil,
+		"Period: 2023-06.":              {[]int{8, 15}},
+	}
+
+	finder := NewPeriodFinder()
+
+	for str, allIndices := range finderData {
+		allResult := finder.FindAllIndex([]byte(str), -1)
+		if len(allResult) != len(allIndices) {
+			t.Errorf("Found %d periods in %#v, but expected %d", len(allResult), str, len(allIndices))
+			continue
+		}
+		for i := range allIndices {
+			indices := allIndices[i]
+			result := allResult[i]
+			if result[0] != indices[0] || result[1] != indices[1] {
+				t.Errorf("Found period %#v at wrong position in %#v. Expected: %v, Result: %v", str[result[0]:result[1]], str, indices, result)
+			}
+		}
+	}
+
+	assert.Equal(t, []string{"2023-Q2", "2022-Q2"}, finder.FindAll("Report 2023-Q2 vs 2022-Q2"))
+	assert.Equal(t, [][]int{{7, 14}}, finder.FindAllIndex([]byte("Report 2023-Q2 vs 2022-Q2"), 1))
+	assert.Nil(t, finder.FindAll("no periods"))
+}
+
 func Test_PeriodRange(t *testing.T) {
 	periodDates := map[string][2]Date{
 		"2018-01": {"2018-01-01", "2018-01-31"},
@@ -301,6 +502,40 @@ func Test_PeriodRange(t *testing.T) {
 	}
 
 }
+
+func TestPeriodRangeWithWeekStart(t *testing.T) {
+	tests := []struct {
+		period    string
+		weekStart time.Weekday
+		wantFrom  Date
+		wantUntil Date
+	}{
+		{period: "2019-W01", weekStart: time.Monday, wantFrom: "2018-12-31", wantUntil: "2019-01-06"},
+		{period: "2019-W01", weekStart: time.Sunday, wantFrom: "2018-12-30", wantUntil: "2019-01-05"},
+		{period: "2019-W02", weekStart: time.Monday, wantFrom: "2019-01-07", wantUntil: "2019-01-13"},
+		{period: "2019-W02", weekStart: time.Sunday, wantFrom: "2019-01-06", wantUntil: "2019-01-12"},
+		// January 1st 2023 is a Sunday
+		{period: "2023-W01", weekStart: time.Monday, wantFrom: "2023-01-02", wantUntil: "2023-01-08"},
+		{period: "2023-W01", weekStart: time.Sunday, wantFrom: "2023-01-01", wantUntil: "2023-01-07"},
+		// Non week periods are not affected
+		{period: "2019-01", weekStart: time.Sunday, wantFrom: "2019-01-01", wantUntil: "2019-01-31"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s", tt.period, tt.weekStart), func(t *testing.T) {
+			from, until, err := PeriodRangeWithWeekStart(tt.period, tt.weekStart)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFrom, from, "from")
+			assert.Equal(t, tt.wantUntil, until, "until")
+			if tt.period[5] == 'W' {
+				assert.Equal(t, tt.weekStart, from.Weekday(), "week start weekday")
+			}
+		})
+	}
+
+	_, _, err := PeriodRangeWithWeekStart("2019-W54", time.Sunday)
+	assert.Error(t, err)
+}
+
 func Test_YearRange(t *testing.T) {
 	periodDates := map[int][2]Date{
 		-333: {"-333-01-01", "-333-12-31"},
@@ -369,6 +604,42 @@ func Test_Date_UnmarshalJSON(t *testing.T) {
 	assert.False(t, s.Invalid.Valid(), "invalid Date parsed as is, not valid")
 }
 
+func TestDate_MarshalText(t *testing.T) {
+	text, err := Date("").MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "", string(text))
+	text, err = Date("29.02.2024").MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-02-29", string(text))
+	_, err = Date("Not a date!").MarshalText()
+	assert.Error(t, err)
+
+	var date Date
+	assert.NoError(t, date.UnmarshalText([]byte("2024-2-29")))
+	assert.Equal(t, Date("2024-02-29"), date)
+	assert.NoError(t, date.UnmarshalText(nil))
+	assert.Equal(t, Date(""), date)
+	assert.Error(t, date.UnmarshalText([]byte("Not a date!")))
+
+	// JSON map keys are unmarshalled with UnmarshalText
+	m := map[Date]int{"2024-02-29": 1, "2024-03-01": 2}
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"2024-02-29":1,"2024-03-01":2}`, string(data))
+	var parsed map[Date]int
+	assert.NoError(t, json.Unmarshal(data, &parsed))
+	assert.Equal(t, m, parsed)
+	parsed = nil
+	assert.NoError(t, json.Unmarshal([]byte(`{"1.3.2024":2}`), &parsed))
+	assert.Equal(t, map[Date]int{"2024-03-01": 2}, parsed, "normalized key")
+	assert.Error(t, json.Unmarshal([]byte(`{"Not a date!":2}`), &parsed))
+
+	// JSON values are not affected by MarshalText
+	data, err = json.Marshal(struct{ D Date }{D: "Not a date!"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"D":"Not a date!"}`, string(data))
+}
+
 func TestDate_Normalized(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -482,3 +753,392 @@ func TestDate_YearMonthDay(t *testing.T) {
 		})
 	}
 }
+
+func TestDate_MarshalBinary(t *testing.T) {
+	dates := []Date{
+		"",
+		"1970-01-01",
+		"1969-12-31",
+		"2000-02-29",
+		"2024-12-31",
+		"0001-02-03",
+		"9999-12-31",
+	}
+	for _, date := range dates {
+		t.Run(string(date), func(t *testing.T) {
+			data, err := date.MarshalBinary()
+			assert.NoError(t, err)
+			assert.Len(t, data, 4)
+			var got Date
+			err = got.UnmarshalBinary(data)
+			assert.NoError(t, err)
+			assert.Equal(t, date, got)
+		})
+	}
+
+	data, err := Date("1970-01-02").MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 0, 0, 0}, data)
+
+	data, err = Date("0001-01-01").MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 0x80}, data, "zero date as sentinel")
+
+	_, err = Date("not a date").MarshalBinary()
+	assert.Error(t, err)
+
+	var date Date
+	assert.Error(t, date.UnmarshalBinary([]byte("2024-01-01")))
+}
+
+func TestDate_Scan(t *testing.T) {
+	tests := []struct {
+		value   any
+		want    Date
+		wantErr bool
+	}{
+		{value: nil, want: ""},
+		{value: "", want: ""},
+		{value: "2024-02-29", want: "2024-02-29"},
+		{value: "29.02.2024", want: "2024-02-29"},
+		{value: []byte("2024-02-29"), want: "2024-02-29"},
+		{value: []byte("2024-02-30"), wantErr: true},
+		{value: "2023-12-25T15:30:00Z", want: "2023-12-25"},
+		{value: "2023-12-25T15:30:00+01:00", want: "2023-12-25"},
+		{value: "2023-12-25t15:30:00.123-05:00", want: "2023-12-25"},
+		{value: "2023-12-25 15:30:00", want: "2023-12-25"},
+		{value: "2023-12-25 15:30:00.123456+01", want: "2023-12-25"},
+		{value: []byte("2023-12-25 15:30:00"), want: "2023-12-25"},
+		{value: "2023-12-25 15", wantErr: true},
+		{value: time.Date(2024, 2, 29, 23, 59, 0, 0, time.UTC), want: "2024-02-29"},
+		{value: int64(0), want: "1970-01-01"},
+		{value: int64(1709251199), want: "2024-02-29"}, // 2024-02-29T23:59:59Z
+		{value: int64(1709251200), want: "2024-03-01"},
+		{value: int64(-86400), want: "1969-12-31"},
+		{value: int(1709251200), wantErr: true},
+		{value: 1.5, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%T(%v)", tt.value, tt.value), func(t *testing.T) {
+			var date Date
+			err := date.Scan(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, date)
+
+			var n NullableDate
+			err = n.Scan(tt.value)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.Nullable(), n)
+		})
+	}
+}
+
+func TestOfISOWeekday(t *testing.T) {
+	tests := []struct {
+		isoYear int
+		week    int
+		weekday time.Weekday
+		want    Date
+	}{
+		{isoYear: 2020, week: 1, weekday: time.Monday, want: "2019-12-30"},
+		{isoYear: 2020, week: 53, weekday: time.Monday, want: "2020-12-28"},
+		{isoYear: 2020, week: 53, weekday: time.Friday, want: "2021-01-01"},
+		{isoYear: 2020, week: 53, weekday: time.Sunday, want: "2021-01-03"},
+		{isoYear: 2021, week: 1, weekday: time.Monday, want: "2021-01-04"},
+		{isoYear: 2021, week: 52, weekday: time.Sunday, want: "2022-01-02"},
+		{isoYear: 2023, week: 1, weekday: time.Monday, want: "2023-01-02"},
+		{isoYear: 2026, week: 1, weekday: time.Thursday, want: "2026-01-01"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d-W%02d-%s", tt.isoYear, tt.week, tt.weekday), func(t *testing.T) {
+			got := OfISOWeekday(tt.isoYear, tt.week, tt.weekday)
+			assert.Equal(t, tt.want, got)
+			isoYear, week := got.ISOWeek()
+			assert.Equal(t, tt.isoYear, isoYear, "ISOWeek year round-trip")
+			assert.Equal(t, tt.week, week, "ISOWeek week round-trip")
+			assert.Equal(t, tt.weekday, got.Weekday(), "Weekday round-trip")
+		})
+	}
+
+	for date := Date("2020-12-20"); date.Before("2021-01-20"); date = date.AddDays(1) {
+		isoYear, week := date.ISOWeek()
+		assert.Equal(t, date, OfISOWeekday(isoYear, week, date.Weekday()), "round-trip %s", date)
+	}
+}
+
+func TestOfChecked(t *testing.T) {
+	tests := []struct {
+		year    int
+		month   time.Month
+		day     int
+		want    Date
+		wantErr bool
+	}{
+		{year: 2024, month: 1, day: 31, want: "2024-01-31"},
+		{year: 2024, month: 2, day: 29, want: "2024-02-29"},
+		{year: 2023, month: 12, day: 1, want: "2023-12-01"},
+		{year: 1, month: 2, day: 3, want: "0001-02-03"},
+		// Errors
+		{year: 2024, month: 13, day: 1, wantErr: true},
+		{year: 2024, month: 0, day: 1, wantErr: true},
+		{year: 2024, month: 1, day: 32, wantErr: true},
+		{year: 2024, month: 1, day: 0, wantErr: true},
+		{year: 2023, month: 2, day: 29, wantErr: true},
+		{year: 2024, month: 4, day: 31, wantErr: true},
+		{year: 0, month: 1, day: 1, wantErr: true},
+		{year: 10000, month: 1, day: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d-%d-%d", tt.year, tt.month, tt.day), func(t *testing.T) {
+			got, err := OfChecked(tt.year, tt.month, tt.day)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalizeAnchor(t *testing.T) {
+	tests := map[string]Date{
+		"Q2/2023":    "2023-04-01",
+		"q2 2023":    "2023-04-01",
+		"2023-Q2":    "2023-04-01",
+		"2023 Q4":    "2023-10-01",
+		"H1 2023":    "2023-01-01",
+		"H2/2023":    "2023-07-01",
+		"2023-W02":   "2023-01-09",
+		"W2/2023":    "2023-01-09",
+		"2019-W01":   "2018-12-31",
+		"2023-06":    "2023-06-01",
+		"06/2023":    "2023-06-01",
+		"6.2023":     "2023-06-01",
+		"2023":       "2023-01-01",
+		"24.12.2023": "2023-12-24",
+		"2023-12-24": "2023-12-24",
+	}
+	for str, want := range tests {
+		t.Run(str, func(t *testing.T) {
+			got, err := NormalizeAnchor(str)
+			assert.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+
+	for _, str := range []string{"", "Q5/2023", "H3 2023", "2023-W54", "13/2023", "Q2", "not a date"} {
+		t.Run(str, func(t *testing.T) {
+			_, err := NormalizeAnchor(str)
+			assert.Error(t, err)
+		})
+	}
+
+	// Strict Normalize still rejects period notations
+	for _, str := range []string{"Q2/2023", "2023-W02", "H1 2023"} {
+		_, err := Normalize(str)
+		assert.Error(t, err, "Normalize(%q)", str)
+	}
+}
+
+func TestDate_WithYearMonthDay(t *testing.T) {
+	assert.Equal(t, Date("2023-06-15"), Date("2024-06-15").WithYear(2023))
+	assert.Equal(t, Date("2028-02-29"), Date("2024-02-29").WithYear(2028), "leap year to leap year")
+	assert.Equal(t, Date("2023-03-01"), Date("2024-02-29").WithYear(2023), "leap day to non leap year")
+
+	assert.Equal(t, Date("2024-11-15"), Date("2024-06-15").WithMonth(time.November))
+	assert.Equal(t, Date("2023-03-03"), Date("2023-01-31").WithMonth(time.February), "Feb 31 overflows into March")
+	assert.Equal(t, Date("2024-03-02"), Date("2024-01-31").WithMonth(time.February), "Feb 31 overflows into March in leap year")
+
+	assert.Equal(t, Date("2024-06-01"), Date("2024-06-15").WithDay(1))
+	assert.Equal(t, Date("2023-03-03"), Date("2023-02-10").WithDay(31), "Feb 31 overflows into March")
+
+	assert.Equal(t, Date("invalid"), Date("invalid").WithYear(2023))
+	assert.Equal(t, Date(""), Date("").WithMonth(time.May))
+	assert.Equal(t, Date(""), Date("").WithDay(1))
+}
+
+func TestNormalizeISO(t *testing.T) {
+	tests := map[string]Date{
+		"2023-001":   "2023-01-01",
+		"2023-365":   "2023-12-31",
+		"2024-366":   "2024-12-31",
+		"2023-359":   "2023-12-25",
+		"2023359":    "2023-12-25",
+		"2023-W52-1": "2023-12-25",
+		"2023-W52-7": "2023-12-31",
+		"2023W521":   "2023-12-25",
+		"2023-W01":   "2023-01-02",
+		"2020-W53-5": "2021-01-01",
+		"2023-12-25": "2023-12-25",
+		"25.12.2023": "2023-12-25",
+	}
+	for str, want := range tests {
+		t.Run(str, func(t *testing.T) {
+			got, err := NormalizeISO(str)
+			assert.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+
+	for _, str := range []string{"", "2023-000", "2023-366", "2023-W53-1", "2023-W00-1", "2023-W52-8", "2023-W52-0", "not a date"} {
+		t.Run(str, func(t *testing.T) {
+			_, err := NormalizeISO(str)
+			assert.Error(t, err)
+		})
+	}
+
+	// Standard Normalize does not parse week dates
+	_, err := Normalize("2023-W52-1")
+	assert.Error(t, err)
+}
+
+func TestNormalize_Ordinal(t *testing.T) {
+	tests := map[string]Date{
+		"2023-001": "2023-01-01",
+		"2023-032": "2023-02-01",
+		"2023-359": "2023-12-25",
+		"2023-365": "2023-12-31",
+		"2024-060": "2024-02-29",
+		"2024-366": "2024-12-31",
+	}
+	for str, want := range tests {
+		t.Run(str, func(t *testing.T) {
+			got, err := Normalize(str)
+			assert.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+
+	for _, str := range []string{"2023-000", "2023-366", "2024-367", "2023-999"} {
+		t.Run(str, func(t *testing.T) {
+			_, err := Normalize(str)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseLoose(t *testing.T) {
+	tests := map[string]Date{
+		"1703462400000":                   "2023-12-25", // millis
+		"1703462400":                      "2023-12-25", // seconds
+		"1703548799999":                   "2023-12-25", // last milli of the day
+		" 1703462400 ":                    "2023-12-25",
+		"Mon, 25 Dec 2023 10:30:00 UTC":   "2023-12-25",
+		"Mon, 25 Dec 2023 23:30:00 -0500": "2023-12-25",
+		"25.12.2023":                      "2023-12-25",
+		"20231225":                        "2023-12-25", // compact date, not epoch
+	}
+	for str, want := range tests {
+		t.Run(str, func(t *testing.T) {
+			got, err := ParseLoose(str)
+			assert.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+
+	for _, str := range []string{"", "99999999999999999999", "Mon, 32 Dec 2023 10:30:00 UTC", "not a date"} {
+		t.Run(str, func(t *testing.T) {
+			_, err := ParseLoose(str)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseAny(t *testing.T) {
+	tests := []struct {
+		value string
+		lang  []language.Code
+		want  Date
+	}{
+		{value: "2023-12-25T23:30:00-05:00", want: "2023-12-25"},
+		{value: "2023-12-25T01:30:00.123+02:00", want: "2023-12-25"},
+		{value: "2023-12-25", want: "2023-12-25"},
+		{value: "2023-12-25 10:30:00", want: "2023-12-25"},
+		{value: "Mon, 25 Dec 2023 10:30:00 UTC", want: "2023-12-25"},
+		{value: "25.12.2023", want: "2023-12-25"},
+		{value: " 25.12.2023 ", want: "2023-12-25"},
+		{value: "25 December 2023", want: "2023-12-25"},
+		{value: "December 25, 2023", want: "2023-12-25"},
+		{value: "Dec 25, 2023", want: "2023-12-25"},
+		{value: "25. Dezember 2023", lang: []language.Code{language.DE}, want: "2023-12-25"},
+		{value: "12/25/2023", lang: []language.Code{language.EN}, want: "2023-12-25"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseAny(tt.value, tt.lang...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, value := range []string{"", "not a date", "2023-13-45", "32.12.2023"} {
+		t.Run(value, func(t *testing.T) {
+			_, err := ParseAny(value)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDate_DaysInMonth(t *testing.T) {
+	tests := []struct {
+		date        Date
+		daysInMonth int
+		isLeapYear  bool
+	}{
+		{"2024-02-10", 29, true},
+		{"2023-02-10", 28, false},
+		{"1900-02-01", 28, false},
+		{"2000-02-01", 29, true},
+		{"2023-04-30", 30, false},
+		{"2023-12-01", 31, false},
+		{"31.01.2024", 31, true},
+		{"", 0, false},
+		{"invalid", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.date), func(t *testing.T) {
+			assert.Equal(t, tt.daysInMonth, tt.date.DaysInMonth())
+			assert.Equal(t, tt.isLeapYear, tt.date.IsLeapYear())
+		})
+	}
+}
+
+func TestFromUntilFromYearAndMonths(t *testing.T) {
+	tests := []struct {
+		year      string
+		months    string
+		wantFrom  Date
+		wantUntil Date
+		wantErr   bool
+	}{
+		{"", "5", "", "", false},
+		{"2024", "", "2024-01-01", "2024-12-31", false},
+		{"2024", "-", "2024-01-01", "2024-12-31", false},
+		{"2024", "2", "2024-02-01", "2024-02-29", false},
+		{"2024", "3-5", "2024-03-01", "2024-05-31", false},
+		{"2024", " 3 - 5 ", "2024-03-01", "2024-05-31", false},
+		{"2024", "5-", "2024-05-01", "2024-12-31", false},
+		{"2024", "-5", "2024-01-01", "2024-05-31", false},
+		{"2024", "3-5-7", "", "", true},
+		{"2024", "-5-", "", "", true},
+		{"2024", "x", "", "", true},
+		{"x", "5", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.year+"/"+tt.months, func(t *testing.T) {
+			from, until, err := FromUntilFromYearAndMonths(tt.year, tt.months)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFrom, from)
+			assert.Equal(t, tt.wantUntil, until)
+		})
+	}
+}
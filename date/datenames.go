@@ -1,5 +1,11 @@
 package date
 
+import (
+	"time"
+
+	"github.com/domonda/go-types/language"
+)
+
 var monthNameMap = map[string]int{
 	"jan":     1,
 	"jän":     1,
@@ -111,3 +117,133 @@ var monthNameMap = map[string]int{
 	"december": 12,
 	"dezember": 12,
 }
+
+// langMonthNameMaps holds month names of languages
+// that are not covered by monthNameMap.
+// A month value of 0 marks a name that is
+// not a month in the language although it is in monthNameMap,
+// like the French "mar" for "mardi" (Tuesday).
+var langMonthNameMaps = map[language.Code]map[string]int{
+	language.FR: {
+		"janv":    1,
+		"janvier": 1,
+
+		"févr":    2,
+		"fevr":    2,
+		"fév":     2,
+		"fev":     2,
+		"février": 2,
+		"fevrier": 2,
+
+		"mar":  0, // mardi
+		"mars": 3,
+
+		"avr":   4,
+		"avril": 4,
+
+		"mai": 5,
+
+		"juin": 6,
+
+		"juil":    7,
+		"juillet": 7,
+
+		"août": 8,
+		"aout": 8,
+
+		"sept":      9,
+		"septembre": 9,
+
+		"oct":     10,
+		"octobre": 10,
+
+		"nov":      11,
+		"novembre": 11,
+
+		"déc":      12,
+		"décembre": 12,
+		"decembre": 12,
+	},
+	language.IT: {
+		"gen":     1,
+		"gennaio": 1,
+
+		"feb":      2,
+		"febbraio": 2,
+
+		"mar":   3,
+		"marzo": 3,
+
+		"apr":    4,
+		"aprile": 4,
+
+		"mag":    5,
+		"maggio": 5,
+
+		"giu":    6,
+		"giugno": 6,
+
+		"lug":    7,
+		"luglio": 7,
+
+		"ago":    8,
+		"agosto": 8,
+
+		"set":       9,
+		"sett":      9,
+		"settembre": 9,
+
+		"ott":     10,
+		"ottobre": 10,
+
+		"nov":      11,
+		"novembre": 11,
+
+		"dic":      12,
+		"dicembre": 12,
+	},
+}
+
+// monthFromName returns the month number for a lower case
+// month name or 0 if name is not a month name.
+// Month names of the langHint language take precedence
+// over monthNameMap. Without a langHint the month names
+// of all languages of langMonthNameMaps are used as fallback.
+func monthFromName(name string, langHint language.Code) int {
+	if names, ok := langMonthNameMaps[langHint]; ok {
+		if month, ok := names[name]; ok {
+			return month
+		}
+	}
+	if month, ok := monthNameMap[name]; ok {
+		return month
+	}
+	if langHint == "" {
+		for _, lang := range []language.Code{language.FR, language.IT} {
+			if month := langMonthNameMaps[lang][name]; month != 0 {
+				return month
+			}
+		}
+	}
+	return 0
+}
+
+// longMonthNames holds the full month names
+// of languages used for display labels.
+var longMonthNames = map[language.Code][12]string{
+	language.EN: {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	language.DE: {"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	language.FR: {"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	language.IT: {"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+}
+
+// longMonthName returns the full name of a month in a language
+// or in English if the language is not in longMonthNames.
+func longMonthName(month time.Month, lang language.Code) string {
+	lang, _ = lang.Normalized()
+	names, ok := longMonthNames[lang]
+	if !ok {
+		names = longMonthNames[language.EN]
+	}
+	return names[month-1]
+}
@@ -12,8 +12,27 @@ func NewFinder(lang ...language.Code) *Finder {
 	return &Finder{LangHint: getLangHint(lang)}
 }
 
+// NewFinderWithOptions returns a Finder for the language hint lang
+// that discards found dates not matching opts.
+func NewFinderWithOptions(lang language.Code, opts FinderOptions) *Finder {
+	return &Finder{LangHint: getLangHint([]language.Code{lang}), Options: opts}
+}
+
+// FinderOptions restrict the dates found by a Finder
+// to reduce false positives in texts with many numbers.
+type FinderOptions struct {
+	// RequireFourDigitYear discards dates
+	// with two digit years like "16.12.98"
+	RequireFourDigitYear bool
+	// MinYear discards dates before this year if not zero
+	MinYear int
+	// MaxYear discards dates after this year if not zero
+	MaxYear int
+}
+
 type Finder struct {
 	LangHint language.Code
+	Options  FinderOptions
 }
 
 func (df *Finder) FindAllIndex(str []byte, n int) (indices [][]int) {
@@ -44,8 +63,8 @@ func (df *Finder) FindAllIndex(str []byte, n int) (indices [][]int) {
 				end -= n
 				r, n = utf8.DecodeLastRune(str[beg:end])
 			}
-			_, _, err := normalizeAndCheckDate(strings.ToLower(s[beg:end]), df.LangHint)
-			if err == nil {
+			date, _, err := normalizeAndCheckDate(strings.ToLower(s[beg:end]), df.LangHint, PreferByLanguage, false)
+			if err == nil && df.Options.accept(date, s[beg:end]) {
 				indices = append(indices, []int{beg, end})
 				break
 			}
@@ -54,3 +73,30 @@ func (df *Finder) FindAllIndex(str []byte, n int) (indices [][]int) {
 
 	return indices
 }
+
+// accept returns if the date found as str
+// is not discarded by the options.
+func (opts *FinderOptions) accept(date Date, str string) bool {
+	year := date.Year()
+	if opts.MinYear != 0 && year < opts.MinYear {
+		return false
+	}
+	if opts.MaxYear != 0 && year > opts.MaxYear {
+		return false
+	}
+	if opts.RequireFourDigitYear {
+		return hasFourDigitYear(str, string(date[:4]))
+	}
+	return true
+}
+
+// hasFourDigitYear returns if str contains
+// the 4 digits of year as separate number.
+func hasFourDigitYear(str, year string) bool {
+	for _, number := range strings.FieldsFunc(str, func(r rune) bool { return r < '0' || r > '9' }) {
+		if number == year {
+			return true
+		}
+	}
+	return false
+}
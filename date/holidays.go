@@ -0,0 +1,136 @@
+package date
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// GermanStates are the ISO 3166-2 subdivision codes
+// of the German federal states (Bundesländer)
+// without the "DE-" prefix as used by PublicHolidaysDE.
+var GermanStates = []string{
+	"BB", // Brandenburg
+	"BE", // Berlin
+	"BW", // Baden-Württemberg
+	"BY", // Bayern
+	"HB", // Bremen
+	"HE", // Hessen
+	"HH", // Hamburg
+	"MV", // Mecklenburg-Vorpommern
+	"NI", // Niedersachsen
+	"NW", // Nordrhein-Westfalen
+	"RP", // Rheinland-Pfalz
+	"SH", // Schleswig-Holstein
+	"SL", // Saarland
+	"SN", // Sachsen
+	"ST", // Sachsen-Anhalt
+	"TH", // Thüringen
+}
+
+// EasterSunday returns the date of Easter Sunday
+// of the Gregorian calendar for a year
+// using the anonymous Gregorian algorithm by Meeus/Jones/Butcher.
+func EasterSunday(year int) Date {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return Of(year, time.Month(month), day)
+}
+
+// PublicHolidaysDE returns the sorted public holidays of Germany
+// for a year and a federal state from GermanStates
+// like "BY" or "DE-BY" for Bavaria.
+// Holidays of an empty or unknown state are the nationwide ones.
+// Holidays that only apply to parts of a state,
+// like Corpus Christi in parts of Saxony and Thuringia
+// or Assumption Day in Catholic communities of Bavaria,
+// are not included.
+func PublicHolidaysDE(year int, state string) []Date {
+	state = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(state)), "DE-")
+	inStates := func(states ...string) bool {
+		return slices.Contains(states, state)
+	}
+	easter := EasterSunday(year)
+
+	holidays := []Date{
+		Of(year, time.January, 1),   // Neujahr
+		easter.AddDays(-2),          // Karfreitag
+		easter.AddDays(1),           // Ostermontag
+		Of(year, time.May, 1),       // Tag der Arbeit
+		easter.AddDays(39),          // Christi Himmelfahrt
+		easter.AddDays(50),          // Pfingstmontag
+		Of(year, time.October, 3),   // Tag der Deutschen Einheit
+		Of(year, time.December, 25), // 1. Weihnachtstag
+		Of(year, time.December, 26), // 2. Weihnachtstag
+	}
+	if inStates("BW", "BY", "ST") {
+		holidays = append(holidays, Of(year, time.January, 6)) // Heilige Drei Könige
+	}
+	if (inStates("BE") && year >= 2019) || (inStates("MV") && year >= 2023) {
+		holidays = append(holidays, Of(year, time.March, 8)) // Internationaler Frauentag
+	}
+	if inStates("BB") {
+		holidays = append(holidays, easter, easter.AddDays(49)) // Ostersonntag, Pfingstsonntag
+	}
+	if inStates("BW", "BY", "HE", "NW", "RP", "SL") {
+		holidays = append(holidays, easter.AddDays(60)) // Fronleichnam
+	}
+	if inStates("SL") {
+		holidays = append(holidays, Of(year, time.August, 15)) // Mariä Himmelfahrt
+	}
+	if inStates("TH") && year >= 2019 {
+		holidays = append(holidays, Of(year, time.September, 20)) // Weltkindertag
+	}
+	if year == 2017 || inStates("BB", "MV", "SN", "ST", "TH") || (inStates("HB", "HH", "NI", "SH") && year >= 2018) {
+		holidays = append(holidays, Of(year, time.October, 31)) // Reformationstag
+	}
+	if inStates("BW", "BY", "NW", "RP", "SL") {
+		holidays = append(holidays, Of(year, time.November, 1)) // Allerheiligen
+	}
+	if inStates("SN") {
+		// Buß- und Bettag is the Wednesday before November 23
+		bettag := Of(year, time.November, 22)
+		for bettag.Weekday() != time.Wednesday {
+			bettag = bettag.AddDays(-1)
+		}
+		holidays = append(holidays, bettag)
+	}
+	slices.Sort(holidays)
+	return holidays
+}
+
+// PublicHolidaysAT returns the sorted nationwide public holidays of Austria
+// for a year. Good Friday is not included because it is
+// not a public holiday for everyone since 2019.
+func PublicHolidaysAT(year int) []Date {
+	easter := EasterSunday(year)
+	holidays := []Date{
+		Of(year, time.January, 1),   // Neujahr
+		Of(year, time.January, 6),   // Heilige Drei Könige
+		easter.AddDays(1),           // Ostermontag
+		Of(year, time.May, 1),       // Staatsfeiertag
+		easter.AddDays(39),          // Christi Himmelfahrt
+		easter.AddDays(50),          // Pfingstmontag
+		easter.AddDays(60),          // Fronleichnam
+		Of(year, time.August, 15),   // Mariä Himmelfahrt
+		Of(year, time.October, 26),  // Nationalfeiertag
+		Of(year, time.November, 1),  // Allerheiligen
+		Of(year, time.December, 8),  // Mariä Empfängnis
+		Of(year, time.December, 25), // Christtag
+		Of(year, time.December, 26), // Stefanitag
+	}
+	slices.Sort(holidays)
+	return holidays
+}
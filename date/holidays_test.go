@@ -0,0 +1,60 @@
+package date
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEasterSunday(t *testing.T) {
+	tests := map[int]Date{
+		1961: "1961-04-02",
+		2000: "2000-04-23",
+		2008: "2008-03-23",
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2038: "2038-04-25",
+	}
+	for year, want := range tests {
+		assert.Equal(t, want, EasterSunday(year), "Easter %d", year)
+	}
+}
+
+func TestPublicHolidaysDE(t *testing.T) {
+	for _, state := range append([]string{""}, GermanStates...) {
+		holidays := PublicHolidaysDE(2024, state)
+		assert.True(t, slices.IsSorted(holidays), "state %q", state)
+		assert.Contains(t, holidays, Date("2024-12-25"), "state %q", state)
+		assert.Contains(t, holidays, Date("2024-03-29"), "Good Friday in state %q", state)
+		assert.Contains(t, holidays, Date("2024-04-01"), "Easter Monday in state %q", state)
+		assert.Contains(t, holidays, Date("2024-05-09"), "Ascension in state %q", state)
+		assert.Contains(t, holidays, Date("2024-05-20"), "Whit Monday in state %q", state)
+	}
+
+	assert.Len(t, PublicHolidaysDE(2024, ""), 9)
+	assert.Contains(t, PublicHolidaysDE(2024, "BY"), Date("2024-05-30"), "Corpus Christi in Bavaria")
+	assert.Contains(t, PublicHolidaysDE(2024, "de-by"), Date("2024-01-06"))
+	assert.NotContains(t, PublicHolidaysDE(2024, "BE"), Date("2024-05-30"))
+	assert.Contains(t, PublicHolidaysDE(2024, "SN"), Date("2024-10-31"), "Reformation Day in Saxony")
+	assert.Contains(t, PublicHolidaysDE(2024, "SN"), Date("2024-11-20"), "Day of Repentance in Saxony")
+	assert.NotContains(t, PublicHolidaysDE(2024, "BY"), Date("2024-10-31"))
+	assert.NotContains(t, PublicHolidaysDE(2016, "HH"), Date("2016-10-31"), "Reformation Day in Hamburg since 2018")
+	assert.Contains(t, PublicHolidaysDE(2017, "BY"), Date("2017-10-31"), "Reformation Day nationwide in 2017")
+	assert.Contains(t, PublicHolidaysDE(2024, "BE"), Date("2024-03-08"))
+	assert.NotContains(t, PublicHolidaysDE(2018, "BE"), Date("2018-03-08"))
+}
+
+func TestPublicHolidaysAT(t *testing.T) {
+	holidays := PublicHolidaysAT(2024)
+	assert.Len(t, holidays, 13)
+	assert.True(t, slices.IsSorted(holidays))
+	assert.Contains(t, holidays, Date("2024-12-25"))
+	assert.Contains(t, holidays, Date("2024-10-26"))
+	assert.Contains(t, holidays, Date("2024-05-30"), "Corpus Christi")
+	assert.NotContains(t, holidays, Date("2024-03-29"), "no Good Friday")
+
+	cal := NewCalendar(holidays...)
+	assert.Equal(t, Date("2024-04-02"), cal.AddBusinessDays("2024-03-29", 1), "Easter Monday skipped")
+}
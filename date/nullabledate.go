@@ -156,7 +156,7 @@ func (n *NullableDate) ScanStringWithLang(source string, lang language.Code) (wa
 		*n = Null
 		return false, false, nil
 	}
-	newDate, monthMustBeFirst, err := normalizeAndCheckDate(source, lang)
+	newDate, monthMustBeFirst, err := normalizeAndCheckDate(source, lang, PreferByLanguage, true)
 	if err != nil {
 		return false, false, err
 	}
@@ -360,6 +360,17 @@ func (n NullableDate) Sub(other NullableDate) time.Duration {
 	return Date(n).Sub(Date(other))
 }
 
+// SubDays returns the number of calendar days between
+// other and n, see Date.SubDays.
+// The returned ok is false if n or other is null or not valid
+// to distinguish them from dates that are zero days apart.
+func (n NullableDate) SubDays(other NullableDate) (days int, ok bool) {
+	if n.IsNull() || other.IsNull() {
+		return 0, false
+	}
+	return Date(n).subDays(Date(other))
+}
+
 func (n NullableDate) BeginningOfWeek() NullableDate {
 	if n.IsNull() {
 		return Null
@@ -460,6 +471,43 @@ func (n NullableDate) Day() int {
 	return day
 }
 
+// Quarter of the year of the date from 1 to 4
+// or zero if the date is null or not valid.
+func (n NullableDate) Quarter() int {
+	if n.IsNull() {
+		return 0
+	}
+	return Date(n).Quarter()
+}
+
+// DaysInMonth returns the number of days
+// in the month of the date from 28 to 31
+// or zero if the date is null or not valid.
+func (n NullableDate) DaysInMonth() int {
+	if n.IsNull() {
+		return 0
+	}
+	return Date(n).DaysInMonth()
+}
+
+// IsLeapYear returns if the year of the date is a leap year
+// or false if the date is null or not valid.
+func (n NullableDate) IsLeapYear() bool {
+	if n.IsNull() {
+		return false
+	}
+	return Date(n).IsLeapYear()
+}
+
+// YearQuarter returns the YearQuarter of the date
+// or YearQuarterNull if the date is null or not valid.
+func (n NullableDate) YearQuarter() NullableYearQuarter {
+	if n.IsNull() {
+		return YearQuarterNull
+	}
+	return NullableYearQuarter(Date(n).YearQuarter())
+}
+
 // Weekday returns the date's day of the week
 // or zero if the date is null.
 func (n NullableDate) Weekday() time.Weekday {
@@ -538,6 +586,10 @@ func (n NullableDate) Format(layout string) string {
 }
 
 // Scan implements the database/sql.Scanner interface.
+// Supported value types are string, []byte, time.Time,
+// int64 as Unix seconds interpreted in UTC, and nil.
+// Plain int values are rejected because database/sql
+// drivers return all integers as int64.
 func (n *NullableDate) Scan(value any) (err error) {
 	switch x := value.(type) {
 	case string:
@@ -551,10 +603,17 @@ func (n *NullableDate) Scan(value any) (err error) {
 		*n = d.Nullable()
 		return nil
 
+	case []byte:
+		return n.Scan(string(x))
+
 	case time.Time:
 		*n = OfTime(x).Nullable()
 		return nil
 
+	case int64:
+		*n = OfTime(time.Unix(x, 0).UTC()).Nullable()
+		return nil
+
 	case nil:
 		*n = Null
 		return nil
@@ -583,3 +642,34 @@ func (n NullableDate) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(string(n))
 }
+
+// UnmarshalJSON implements encoding/json.Unmarshaler
+// by setting the date unchanged from a JSON string
+// without validation so that UnmarshalText is not used for JSON.
+// The JSON null value is ignored like for any string type.
+func (n *NullableDate) UnmarshalJSON(sourceJSON []byte) error {
+	if string(sourceJSON) == "null" {
+		return nil
+	}
+	var s string
+	err := json.Unmarshal(sourceJSON, &s)
+	if err != nil {
+		return fmt.Errorf("can't unmarshal JSON %s as date.NullableDate: %w", sourceJSON, err)
+	}
+	*n = NullableDate(s)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+// by returning the normalized date or empty text for Null.
+// An error is returned for a non null invalid date.
+func (n NullableDate) MarshalText() ([]byte, error) {
+	return Date(n).MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+// by normalizing the text. Empty text is unmarshalled as Null.
+// An error is returned for text that can't be normalized.
+func (n *NullableDate) UnmarshalText(text []byte) error {
+	return (*Date)(n).UnmarshalText(text)
+}
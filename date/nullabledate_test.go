@@ -40,3 +40,77 @@ func Test_NullableDate_UnmarshalJSON(t *testing.T) {
 	assert.Equal(t, NullableDate("Not a date!"), s.Invalid, "invalid NullableDate parsed as is, without error")
 	assert.False(t, s.Invalid.Valid(), "invalid NullableDate parsed as is, not valid")
 }
+
+func TestNullableDate_MarshalText(t *testing.T) {
+	text, err := Null.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "", string(text))
+	text, err = NullableDate("29.02.2024").MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-02-29", string(text))
+	_, err = NullableDate("Not a date!").MarshalText()
+	assert.Error(t, err)
+
+	n := NullableDate("2024-01-01")
+	assert.NoError(t, n.UnmarshalText(nil))
+	assert.Equal(t, Null, n)
+	assert.NoError(t, n.UnmarshalText([]byte("2024-2-29")))
+	assert.Equal(t, NullableDate("2024-02-29"), n)
+	assert.Error(t, n.UnmarshalText([]byte("Not a date!")))
+
+	m := map[NullableDate]int{"2024-02-29": 1}
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	var parsed map[NullableDate]int
+	assert.NoError(t, json.Unmarshal(data, &parsed))
+	assert.Equal(t, m, parsed)
+}
+
+func TestNullableDate_SubDays(t *testing.T) {
+	days, ok := NullableDate("2024-03-01").SubDays("2024-03-01")
+	assert.True(t, ok, "equal non null dates")
+	assert.Equal(t, 0, days)
+
+	days, ok = NullableDate("2024-03-01").SubDays("2024-02-28")
+	assert.True(t, ok)
+	assert.Equal(t, 2, days, "leap year")
+
+	// Daylight saving time change in Europe on 2024-03-31
+	days, ok = NullableDate("2024-03-30").SubDays("2024-04-02")
+	assert.True(t, ok)
+	assert.Equal(t, -3, days)
+
+	days, ok = NullableDate("2024-03-01").SubDays(Null)
+	assert.False(t, ok, "null other")
+	assert.Equal(t, 0, days)
+
+	days, ok = Null.SubDays("2024-03-01")
+	assert.False(t, ok, "null date")
+	assert.Equal(t, 0, days)
+
+	days, ok = NullableDate("2024-02-30").SubDays("2024-03-01")
+	assert.False(t, ok, "invalid date")
+	assert.Equal(t, 0, days)
+
+	days, ok = NullableDate("2024-03-01").SubDays("invalid")
+	assert.False(t, ok, "invalid other")
+	assert.Equal(t, 0, days)
+
+	days, ok = NullableDate("01.03.2024").SubDays("2024-02-28")
+	assert.True(t, ok, "not normalized")
+	assert.Equal(t, 2, days)
+
+	// More days than fit into a time.Duration
+	days, ok = NullableDate("2500-01-01").SubDays("2000-01-01")
+	assert.True(t, ok)
+	assert.Equal(t, 182622, days)
+}
+
+func TestNullableDate_DaysInMonth(t *testing.T) {
+	assert.Equal(t, 29, NullableDate("2024-02-01").DaysInMonth())
+	assert.True(t, NullableDate("2024-02-01").IsLeapYear())
+	assert.Equal(t, 30, NullableDate("2023-06-15").DaysInMonth())
+	assert.False(t, NullableDate("2023-06-15").IsLeapYear())
+	assert.Equal(t, 0, Null.DaysInMonth())
+	assert.False(t, Null.IsLeapYear())
+}
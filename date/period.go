@@ -0,0 +1,161 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/domonda/go-types/strutil"
+)
+
+// Period is a calendar based span of years, months, and days
+// that can't be represented by a time.Duration
+// because months and years have different lengths.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// ParsePeriod parses an ISO 8601 duration with date
+// components like "P1Y2M10D" or "P3W" as Period.
+// Weeks are converted to 7 days.
+// Every component and the whole duration can have a sign
+// like "-P1M" or "P-1M-1D".
+// Time components after 'T' are not supported.
+func ParsePeriod(str string) (Period, error) {
+	s := strings.ToUpper(strutil.TrimSpace(str))
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return Period{}, fmt.Errorf("invalid ISO 8601 period: %q", str)
+	}
+	var (
+		p     Period
+		order = "YMWD"
+		start = 1
+	)
+	for i := 1; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' || s[i] == '-' || s[i] == '+' {
+			continue
+		}
+		pos := strings.IndexByte(order, s[i])
+		if pos == -1 || i == start {
+			return Period{}, fmt.Errorf("invalid ISO 8601 period: %q", str)
+		}
+		n, err := strconv.Atoi(s[start:i])
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid ISO 8601 period: %q", str)
+		}
+		switch s[i] {
+		case 'Y':
+			p.Years = n
+		case 'M':
+			p.Months = n
+		case 'W':
+			p.Days += n * 7
+		case 'D':
+			p.Days += n
+		}
+		// Components must be unique and in order
+		order = order[pos+1:]
+		start = i + 1
+	}
+	if start != len(s) {
+		return Period{}, fmt.Errorf("invalid ISO 8601 period: %q", str)
+	}
+	if negative {
+		p = p.Negated()
+	}
+	return p, nil
+}
+
+// PeriodBetween returns the Period that has to be added
+// to from with Date.AddPeriod to get until.
+// The days are counted after adding the full months,
+// so from 2023-01-31 until 2023-03-01 is one month
+// to 2023-02-28 and one more day.
+// All components are negative if until is before from,
+// but note that subtracting a month is also clamped
+// to the end of the month, so from 2023-03-31
+// until 2023-02-28 is exactly minus one month.
+// A zero Period is returned if from or until is not valid.
+func PeriodBetween(from, until Date) Period {
+	if !from.Valid() || !until.Valid() {
+		return Period{}
+	}
+	fromYear, fromMonth, fromDay := from.YearMonthDay()
+	untilYear, untilMonth, untilDay := until.YearMonthDay()
+	months := (untilYear-fromYear)*12 + int(untilMonth-fromMonth)
+	// Don't count the last month if it's not complete
+	switch days := untilDay - fromDay; {
+	case months > 0 && days < 0:
+		months--
+	case months < 0 && days > 0:
+		months++
+	}
+	// Count days from the date after adding the months
+	// because its day might be clamped to the end of the month
+	days := until.SubDays(from.AddPeriod(Period{Months: months}))
+	return Period{Years: months / 12, Months: months % 12, Days: days}
+}
+
+// AddPeriod returns the date with the years and months
+// of the period added, keeping the day of the month
+// or using the last day of shorter months,
+// and then adding the days of the period.
+// So 2024-01-31 plus one month is 2024-02-29.
+func (date Date) AddPeriod(p Period) Date {
+	year, month, day := date.YearMonthDay()
+	month += time.Month(p.Years*12 + p.Months)
+	lastDay := Of(year, month+1, 0).Day()
+	return Of(year, month, min(day, lastDay)+p.Days)
+}
+
+// AddPeriod returns the date with the period added
+// or Null if the date is null.
+// See Date.AddPeriod.
+func (n NullableDate) AddPeriod(p Period) NullableDate {
+	if n.IsNull() {
+		return Null
+	}
+	return Date(n).AddPeriod(p).Nullable()
+}
+
+// IsZero returns true if all components of the period are zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negated returns the period with all components negated.
+func (p Period) Negated() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+}
+
+// String returns the period in ISO 8601 duration
+// format like "P1Y2M10D" omitting zero components.
+// A zero period is returned as "P0D".
+// String implements the fmt.Stringer interface.
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+	var b strings.Builder
+	b.WriteByte('P')
+	if p.Years != 0 {
+		b.WriteString(strconv.Itoa(p.Years))
+		b.WriteByte('Y')
+	}
+	if p.Months != 0 {
+		b.WriteString(strconv.Itoa(p.Months))
+		b.WriteByte('M')
+	}
+	if p.Days != 0 {
+		b.WriteString(strconv.Itoa(p.Days))
+		b.WriteByte('D')
+	}
+	return b.String()
+}
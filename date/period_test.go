@@ -0,0 +1,113 @@
+package date
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodBetween(t *testing.T) {
+	tests := []struct {
+		from  Date
+		until Date
+		want  Period
+	}{
+		{from: "2023-01-01", until: "2023-01-01", want: Period{}},
+		{from: "2023-01-01", until: "2023-01-31", want: Period{Days: 30}},
+		{from: "2023-01-01", until: "2023-02-01", want: Period{Months: 1}},
+		{from: "2023-01-31", until: "2023-02-28", want: Period{Days: 28}},
+		{from: "2023-01-31", until: "2023-03-01", want: Period{Months: 1, Days: 1}},
+		{from: "2024-01-31", until: "2024-03-01", want: Period{Months: 1, Days: 1}},
+		{from: "2023-01-31", until: "2023-03-31", want: Period{Months: 2}},
+		{from: "2023-03-31", until: "2023-04-30", want: Period{Days: 30}},
+		{from: "2023-05-15", until: "2024-07-20", want: Period{Years: 1, Months: 2, Days: 5}},
+		{from: "2020-02-29", until: "2021-02-28", want: Period{Months: 11, Days: 30}},
+		{from: "2020-02-29", until: "2024-02-29", want: Period{Years: 4}},
+		{from: "2023-12-31", until: "2024-01-01", want: Period{Days: 1}},
+		{from: "2023-03-01", until: "2023-01-31", want: Period{Months: -1, Days: -1}},
+		{from: "2024-07-20", until: "2023-05-15", want: Period{Years: -1, Months: -2, Days: -5}},
+		{from: "2023-03-31", until: "2023-02-28", want: Period{Months: -1}},
+		{from: "2023-03-29", until: "2023-01-31", want: Period{Months: -1, Days: -28}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"/"+string(tt.until), func(t *testing.T) {
+			got := PeriodBetween(tt.from, tt.until)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.until, tt.from.AddPeriod(got), "AddPeriod(PeriodBetween) round trip")
+		})
+	}
+}
+
+func TestPeriodBetween_Invalid(t *testing.T) {
+	assert.Equal(t, Period{}, PeriodBetween("", "2023-01-01"))
+	assert.Equal(t, Period{}, PeriodBetween("2023-01-01", ""))
+	assert.Equal(t, Period{}, PeriodBetween("invalid", "2023-01-01"))
+	assert.Equal(t, Period{}, PeriodBetween("2023-01-01", "2023-02-30"))
+}
+
+func TestPeriodBetween_AddPeriodRoundTrip(t *testing.T) {
+	from := Date("2023-01-01")
+	for i := 0; i < 2*366; i += 3 {
+		for j := 0; j < 2*366; j += 5 {
+			f, u := from.AddDays(i), from.AddDays(j)
+			p := PeriodBetween(f, u)
+			require.Equal(t, u, f.AddPeriod(p), "PeriodBetween(%s, %s) = %s", f, u, p)
+		}
+	}
+}
+
+func TestDate_AddPeriod(t *testing.T) {
+	assert.Equal(t, Date("2024-02-29"), Date("2024-01-31").AddPeriod(Period{Months: 1}))
+	assert.Equal(t, Date("2023-02-28"), Date("2023-01-31").AddPeriod(Period{Months: 1}))
+	assert.Equal(t, Date("2023-03-01"), Date("2023-01-31").AddPeriod(Period{Months: 1, Days: 1}))
+	assert.Equal(t, Date("2025-02-28"), Date("2024-02-29").AddPeriod(Period{Years: 1}))
+	assert.Equal(t, Date("2024-11-30"), Date("2025-01-31").AddPeriod(Period{Months: -2}))
+	assert.Equal(t, Date("2024-03-10"), Date("2023-01-01").AddPeriod(Period{Years: 1, Months: 2, Days: 9}))
+	assert.Equal(t, Null, Null.AddPeriod(Period{Days: 1}))
+	assert.Equal(t, NullableDate("2024-01-02"), NullableDate("2024-01-01").AddPeriod(Period{Days: 1}))
+}
+
+func TestParsePeriod(t *testing.T) {
+	tests := map[string]Period{
+		"P0D":      {},
+		"P1Y2M10D": {Years: 1, Months: 2, Days: 10},
+		"P1Y":      {Years: 1},
+		"P2M":      {Months: 2},
+		"P10D":     {Days: 10},
+		"P3W":      {Days: 21},
+		"P1W2D":    {Days: 9},
+		"p1y2m10d": {Years: 1, Months: 2, Days: 10},
+		"-P1M1D":   {Months: -1, Days: -1},
+		"P-1M-1D":  {Months: -1, Days: -1},
+		"+P1D":     {Days: 1},
+	}
+	for str, want := range tests {
+		got, err := ParsePeriod(str)
+		require.NoError(t, err, "ParsePeriod(%q)", str)
+		assert.Equal(t, want, got, "ParsePeriod(%q)", str)
+	}
+
+	for _, str := range []string{"", "P", "1Y", "PY", "P1", "P1D1Y", "P1Y1Y", "P1.5D", "PT1H", "P1DT1H", "P--1D", "P1X", "--P1D", "+-P1D", "-+P1D", "++P1D"} {
+		_, err := ParsePeriod(str)
+		assert.Error(t, err, "ParsePeriod(%q)", str)
+	}
+}
+
+func TestPeriod_String(t *testing.T) {
+	for _, p := range []Period{
+		{},
+		{Years: 1, Months: 2, Days: 10},
+		{Days: 45},
+		{Years: 3},
+		{Months: -1, Days: -1},
+	} {
+		str := p.String()
+		parsed, err := ParsePeriod(str)
+		require.NoError(t, err, "ParsePeriod(%q)", str)
+		assert.Equal(t, p, parsed, "round trip %q", str)
+	}
+	assert.Equal(t, "P0D", Period{}.String())
+	assert.Equal(t, "P1Y2M10D", Period{Years: 1, Months: 2, Days: 10}.String())
+	assert.Equal(t, "P-1M-1D", Period{Months: -1, Days: -1}.String())
+}
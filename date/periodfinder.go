@@ -0,0 +1,88 @@
+package date
+
+import (
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+)
+
+var periodCandidateRegexp = regexp.MustCompile(`\d{4}-(?:\d{2}|[QqHh]\d|[Ww]\d{2})`)
+
+// NewPeriodFinder returns a PeriodFinder
+// for period tokens in free text.
+func NewPeriodFinder() *PeriodFinder {
+	return &PeriodFinder{}
+}
+
+// PeriodFinder finds period tokens in the forms
+// YYYY-MM, YYYY-Qn, YYYY-Hn, and YYYY-Wnn
+// as accepted by PeriodRange.
+// Tokens that are part of larger numbers, words,
+// or full dates like 2023-06-15 are not matched.
+type PeriodFinder struct{}
+
+// FindAllIndex returns the start and end indices
+// of up to n period tokens in str.
+// If n is negative, all tokens are returned.
+func (pf *PeriodFinder) FindAllIndex(str []byte, n int) (indices [][]int) {
+	if n == 0 {
+		return nil
+	}
+	for _, loc := range periodCandidateRegexp.FindAllIndex(str, -1) {
+		beg, end := loc[0], loc[1]
+		if !isPeriodBoundary(str[:beg], str[end:]) {
+			continue
+		}
+		if _, _, err := PeriodRange(string(str[beg:end])); err != nil {
+			continue
+		}
+		indices = append(indices, []int{beg, end})
+		if len(indices) == n {
+			break
+		}
+	}
+	return indices
+}
+
+// FindAll returns all period tokens in str.
+func (pf *PeriodFinder) FindAll(str string) []string {
+	indices := pf.FindAllIndex([]byte(str), -1)
+	if len(indices) == 0 {
+		return nil
+	}
+	periods := make([]string, len(indices))
+	for i, loc := range indices {
+		periods[i] = str[loc[0]:loc[1]]
+	}
+	return periods
+}
+
+// isPeriodBoundary returns if a period token between before and after
+// is not connected to letters, digits, or further date components.
+func isPeriodBoundary(before, after []byte) bool {
+	if r, n := utf8.DecodeLastRune(before); n > 0 {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+		if isDateComponentSeparator(r) {
+			if r2, n2 := utf8.DecodeLastRune(before[:len(before)-n]); n2 > 0 && unicode.IsDigit(r2) {
+				return false
+			}
+		}
+	}
+	if r, n := utf8.DecodeRune(after); n > 0 {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+		if isDateComponentSeparator(r) {
+			if r2, n2 := utf8.DecodeRune(after[n:]); n2 > 0 && unicode.IsDigit(r2) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isDateComponentSeparator(r rune) bool {
+	return r == '-' || r == '.' || r == '/'
+}
@@ -0,0 +1,347 @@
+package date
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+)
+
+// Range is a date range from From until Until
+// including both dates.
+type Range struct {
+	From  Date
+	Until Date
+}
+
+// Until returns the Range from date until the passed date.
+func (date Date) Until(until Date) Range {
+	return Range{From: date, Until: until}
+}
+
+// Contains returns if date is within the range
+// including From and Until.
+// An empty From or Until is an unbounded side of the range
+// like for a scanned PostgreSQL daterange "[2023-01-01,)".
+// A reversed range with From after Until is treated
+// like the range with From and Until swapped.
+func (r Range) Contains(date Date) bool {
+	r = r.ordered()
+	return !boundAfter(r.From, date) && !boundAfter(date, r.Until)
+}
+
+// Overlaps returns if the range and the other range
+// have at least one day in common.
+// Ranges that only touch at the same day overlap.
+// Empty From or Until dates are unbounded sides
+// and reversed ranges are treated like their swapped ranges.
+func (r Range) Overlaps(other Range) bool {
+	r, other = r.ordered(), other.ordered()
+	return !boundAfter(r.From, other.Until) && !boundAfter(other.From, r.Until)
+}
+
+// Intersection returns the range of days that are
+// in both the range and the other range
+// or false if the ranges don't overlap.
+// Empty From or Until dates are unbounded sides
+// and reversed ranges are treated like their swapped ranges.
+func (r Range) Intersection(other Range) (Range, bool) {
+	if !r.Overlaps(other) {
+		return Range{}, false
+	}
+	r, other = r.ordered(), other.ordered()
+	if r.From.IsZero() || boundAfter(other.From, r.From) {
+		r.From = other.From
+	}
+	if r.Until.IsZero() || boundAfter(r.Until, other.Until) {
+		r.Until = other.Until
+	}
+	return r, true
+}
+
+// Union returns the range covering all days of the range
+// and the other range or false if the ranges neither overlap
+// nor are adjacent, because then the union would include
+// days that are in none of the ranges.
+// Empty From or Until dates are unbounded sides
+// and reversed ranges are treated like their swapped ranges.
+func (r Range) Union(other Range) (Range, bool) {
+	r, other = r.ordered(), other.ordered()
+	if !other.Until.IsZero() && boundAfter(r.From, other.Until.AddDays(1)) ||
+		!r.Until.IsZero() && boundAfter(other.From, r.Until.AddDays(1)) {
+		return Range{}, false
+	}
+	if other.From.IsZero() || boundAfter(r.From, other.From) {
+		r.From = other.From
+	}
+	if other.Until.IsZero() || boundAfter(other.Until, r.Until) {
+		r.Until = other.Until
+	}
+	return r, true
+}
+
+// boundAfter returns if a is after b
+// and false if one of them is an empty unbounded side.
+func boundAfter(a, b Date) bool {
+	return !a.IsZero() && !b.IsZero() && a.After(b)
+}
+
+// Scan implements the database/sql.Scanner interface
+// for a PostgreSQL daterange like "[2023-01-01,2023-02-01)".
+// Exclusive bounds are converted to the inclusive From and Until,
+// so the example is scanned as From 2023-01-01 and Until 2023-01-31.
+// An unbounded side is scanned as empty Date
+// and SQL NULL as the zero Range.
+// The PostgreSQL "empty" range can't be represented
+// and returns an error.
+func (r *Range) Scan(value any) error {
+	switch x := value.(type) {
+	case string:
+		parsed, err := parseDateRange(x)
+		if err != nil {
+			return err
+		}
+		*r = parsed
+		return nil
+
+	case []byte:
+		return r.Scan(string(x))
+
+	case nil:
+		*r = Range{}
+		return nil
+	}
+	return fmt.Errorf("can't scan value '%#v' of type %T as date.Range", value, value)
+}
+
+func parseDateRange(s string) (Range, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 3 || !strings.ContainsRune("[(", rune(s[0])) || !strings.ContainsRune("])", rune(s[len(s)-1])) {
+		return Range{}, fmt.Errorf("invalid daterange: %q", s)
+	}
+	lower, upper, ok := strings.Cut(s[1:len(s)-1], ",")
+	if !ok {
+		return Range{}, fmt.Errorf("invalid daterange: %q", s)
+	}
+	var r Range
+	if lower = strings.Trim(lower, `" `); lower != "" && lower != "-infinity" {
+		from, err := Date(lower).Normalized()
+		if err != nil {
+			return Range{}, fmt.Errorf("invalid daterange %q: %w", s, err)
+		}
+		if s[0] == '(' {
+			from = from.AddDays(1)
+		}
+		r.From = from
+	}
+	if upper = strings.Trim(upper, `" `); upper != "" && upper != "infinity" {
+		until, err := Date(upper).Normalized()
+		if err != nil {
+			return Range{}, fmt.Errorf("invalid daterange %q: %w", s, err)
+		}
+		if s[len(s)-1] == ')' {
+			until = until.AddDays(-1)
+		}
+		r.Until = until
+	}
+	return r, nil
+}
+
+// Value implements the driver database/sql/driver.Valuer interface
+// by returning a PostgreSQL daterange with an inclusive lower
+// and an exclusive upper bound like "[2023-01-01,2023-02-01)"
+// for From 2023-01-01 and Until 2023-01-31.
+// An empty From or Until is returned as unbounded side
+// and the zero Range as SQL NULL.
+// A reversed range is returned with From and Until swapped.
+func (r Range) Value() (driver.Value, error) {
+	if r.From.IsZero() && r.Until.IsZero() {
+		return nil, nil
+	}
+	var b strings.Builder
+	if r.From.IsZero() {
+		b.WriteByte('(')
+	} else {
+		from, err := r.From.Normalized()
+		if err != nil {
+			return nil, err
+		}
+		r.From = from
+	}
+	if !r.Until.IsZero() {
+		until, err := r.Until.Normalized()
+		if err != nil {
+			return nil, err
+		}
+		r.Until = until
+	}
+	if !r.From.IsZero() && !r.Until.IsZero() {
+		r = r.ordered()
+	}
+	if !r.From.IsZero() {
+		b.WriteByte('[')
+		b.WriteString(string(r.From))
+	}
+	b.WriteByte(',')
+	if !r.Until.IsZero() {
+		b.WriteString(string(r.Until.AddDays(1)))
+	}
+	b.WriteByte(')')
+	return b.String(), nil
+}
+
+// rangeJSON is the JSON representation of Range
+type rangeJSON struct {
+	From  Date `json:"from"`
+	Until Date `json:"until"`
+}
+
+// MarshalJSON implements encoding/json.Marshaler
+// by returning an object like {"from":"2023-01-01","until":"2023-01-31"}
+// with both dates inclusive.
+func (r Range) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rangeJSON(r))
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler
+// for an object like {"from":"2023-01-01","until":"2023-01-31"}.
+// The dates are normalized and may be empty,
+// an error is returned for invalid dates.
+func (r *Range) UnmarshalJSON(sourceJSON []byte) error {
+	var parsed rangeJSON
+	err := json.Unmarshal(sourceJSON, &parsed)
+	if err != nil {
+		return fmt.Errorf("can't unmarshal JSON %s as date.Range: %w", sourceJSON, err)
+	}
+	if !parsed.From.IsZero() {
+		if parsed.From, err = parsed.From.Normalized(); err != nil {
+			return fmt.Errorf("can't unmarshal JSON %s as date.Range: %w", sourceJSON, err)
+		}
+	}
+	if !parsed.Until.IsZero() {
+		if parsed.Until, err = parsed.Until.Normalized(); err != nil {
+			return fmt.Errorf("can't unmarshal JSON %s as date.Range: %w", sourceJSON, err)
+		}
+	}
+	*r = Range(parsed)
+	return nil
+}
+
+// ordered returns the range with From and Until
+// swapped if From is after Until.
+// Ranges with an empty unbounded side are returned unchanged.
+func (r Range) ordered() Range {
+	if boundAfter(r.From, r.Until) {
+		return Range{From: r.Until, Until: r.From}
+	}
+	return r
+}
+
+// DatesInRange returns an iterator that yields from
+// and then the results of calling step with the
+// previous date as long as they are not after until.
+// Nothing is yielded if from is after until
+// or if from or until are not valid dates.
+// The iteration stops if step does not return
+// a date after the previous one.
+func DatesInRange(from, until Date, step func(Date) Date) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		from, err := from.Normalized()
+		if err != nil {
+			return
+		}
+		until, err := until.Normalized()
+		if err != nil {
+			return
+		}
+		for date := from; !date.After(until); {
+			if !yield(date) {
+				return
+			}
+			next := step(date)
+			if !next.After(date) {
+				return
+			}
+			date = next
+		}
+	}
+}
+
+// Days returns an iterator over all days of the range.
+func (r Range) Days() iter.Seq[Date] {
+	return DatesInRange(r.From, r.Until, func(d Date) Date { return d.AddDays(1) })
+}
+
+// Weeks returns an iterator over the dates of the range
+// in steps of 7 days starting with r.From.
+func (r Range) Weeks() iter.Seq[Date] {
+	return DatesInRange(r.From, r.Until, func(d Date) Date { return d.AddDays(7) })
+}
+
+// Months returns an iterator over the dates of the range
+// in steps of one month starting with r.From.
+// The day of r.From is used for every month or the last
+// day of shorter months, so 2024-01-31 is followed
+// by 2024-02-29 and 2024-03-31.
+func (r Range) Months() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		from, err := r.From.Normalized()
+		if err != nil {
+			return
+		}
+		year, month, day := from.YearMonthDay()
+		i := 0
+		for date := range DatesInRange(from, r.Until, func(Date) Date {
+			i++
+			lastDay := Of(year, month+time.Month(i)+1, 0)
+			return Of(year, month+time.Month(i), min(day, lastDay.Day()))
+		}) {
+			if !yield(date) {
+				return
+			}
+		}
+	}
+}
+
+// SplitRangeByMonth splits the date range [from, until]
+// into sub-ranges per calendar month.
+// The first and last sub-ranges are clipped to from and until,
+// so they may cover only parts of their months.
+// Returns nil if from or until are not valid dates
+// or if from is after until.
+func SplitRangeByMonth(from, until Date) []Range {
+	return splitRange(from, until, Date.EndOfMonth)
+}
+
+// SplitRangeByQuarter splits the date range [from, until]
+// into sub-ranges per calendar quarter.
+// The first and last sub-ranges are clipped to from and until,
+// so they may cover only parts of their quarters.
+// Returns nil if from or until are not valid dates
+// or if from is after until.
+func SplitRangeByQuarter(from, until Date) []Range {
+	return splitRange(from, until, Date.EndOfQuarter)
+}
+
+func splitRange(from, until Date, endOfPeriod func(Date) Date) []Range {
+	from, err := from.Normalized()
+	if err != nil {
+		return nil
+	}
+	until, err = until.Normalized()
+	if err != nil {
+		return nil
+	}
+	var ranges []Range
+	for !from.After(until) {
+		end := endOfPeriod(from)
+		if end.After(until) {
+			end = until
+		}
+		ranges = append(ranges, Range{From: from, Until: end})
+		from = end.AddDays(1)
+	}
+	return ranges
+}
@@ -0,0 +1,269 @@
+package date
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitRangeByMonth(t *testing.T) {
+	assert.Equal(t,
+		[]Range{
+			{From: "2024-01-15", Until: "2024-01-31"},
+			{From: "2024-02-01", Until: "2024-02-29"},
+			{From: "2024-03-01", Until: "2024-03-10"},
+		},
+		SplitRangeByMonth("2024-01-15", "2024-03-10"),
+	)
+	assert.Equal(t,
+		[]Range{{From: "2024-02-10", Until: "2024-02-20"}},
+		SplitRangeByMonth("2024-02-10", "2024-02-20"),
+		"within a single month",
+	)
+	assert.Equal(t,
+		[]Range{{From: "2024-02-10", Until: "2024-02-10"}},
+		SplitRangeByMonth("2024-02-10", "2024-02-10"),
+		"single day",
+	)
+	assert.Equal(t,
+		[]Range{
+			{From: "2023-12-01", Until: "2023-12-31"},
+			{From: "2024-01-01", Until: "2024-01-31"},
+		},
+		SplitRangeByMonth("2023-12-01", "2024-01-31"),
+		"full months across year boundary",
+	)
+	assert.Nil(t, SplitRangeByMonth("2024-03-10", "2024-01-15"), "from after until")
+	assert.Nil(t, SplitRangeByMonth("", "2024-01-15"), "invalid from")
+}
+
+func TestSplitRangeByQuarter(t *testing.T) {
+	assert.Equal(t,
+		[]Range{
+			{From: "2024-02-15", Until: "2024-03-31"},
+			{From: "2024-04-01", Until: "2024-06-30"},
+			{From: "2024-07-01", Until: "2024-07-10"},
+		},
+		SplitRangeByQuarter("2024-02-15", "2024-07-10"),
+	)
+	assert.Equal(t,
+		[]Range{{From: "2024-01-15", Until: "2024-03-10"}},
+		SplitRangeByQuarter("2024-01-15", "2024-03-10"),
+	)
+	assert.Nil(t, SplitRangeByQuarter("2024-07-10", "2024-02-15"))
+}
+
+func TestRange_Days(t *testing.T) {
+	assert.Equal(t,
+		[]Date{"2024-02-27", "2024-02-28", "2024-02-29", "2024-03-01"},
+		slices.Collect(Date("2024-02-27").Until("2024-03-01").Days()),
+		"leap day",
+	)
+	assert.Equal(t,
+		[]Date{"2023-12-31", "2024-01-01"},
+		slices.Collect(Date("2023-12-31").Until("2024-01-01").Days()),
+	)
+	assert.Equal(t, []Date{"2024-01-01"}, slices.Collect(Date("2024-01-01").Until("2024-01-01").Days()))
+	assert.Empty(t, slices.Collect(Date("2024-01-02").Until("2024-01-01").Days()), "from after until")
+	assert.Empty(t, slices.Collect(Date("").Until("2024-01-01").Days()), "invalid from")
+
+	var days []Date
+	for d := range Date("2024-01-01").Until("2024-12-31").Days() {
+		if d == "2024-01-03" {
+			break
+		}
+		days = append(days, d)
+	}
+	assert.Equal(t, []Date{"2024-01-01", "2024-01-02"}, days, "break stops iteration")
+}
+
+func TestRange_Weeks(t *testing.T) {
+	assert.Equal(t,
+		[]Date{"2023-12-25", "2024-01-01", "2024-01-08"},
+		slices.Collect(Date("2023-12-25").Until("2024-01-14").Weeks()),
+	)
+}
+
+func TestRange_Months(t *testing.T) {
+	assert.Equal(t,
+		[]Date{"2023-11-15", "2023-12-15", "2024-01-15", "2024-02-15"},
+		slices.Collect(Date("2023-11-15").Until("2024-02-20").Months()),
+		"across year boundary",
+	)
+	assert.Equal(t,
+		[]Date{"2024-01-31", "2024-02-29", "2024-03-31", "2024-04-30"},
+		slices.Collect(Date("2024-01-31").Until("2024-04-30").Months()),
+		"clamped to the last day of shorter months",
+	)
+	assert.Equal(t,
+		[]Date{"2024-02-29", "2025-03-01"}, // AddYears overflows leap days
+		slices.Collect(DatesInRange("2024-02-29", "2025-03-01", func(d Date) Date { return d.AddYears(1) })),
+	)
+	assert.Empty(t, slices.Collect(Date("2024-03-01").Until("2024-01-01").Months()))
+}
+
+func TestRange_Contains(t *testing.T) {
+	r := Range{From: "2024-01-10", Until: "2024-01-20"}
+	assert.True(t, r.Contains("2024-01-10"), "from is included")
+	assert.True(t, r.Contains("2024-01-15"))
+	assert.True(t, r.Contains("2024-01-20"), "until is included")
+	assert.False(t, r.Contains("2024-01-09"))
+	assert.False(t, r.Contains("2024-01-21"))
+	reversed := Range{From: "2024-01-20", Until: "2024-01-10"}
+	assert.True(t, reversed.Contains("2024-01-15"), "reversed range")
+}
+
+func TestRange_Overlaps(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Range
+		want bool
+	}{
+		{name: "touching", a: Range{"2024-01-01", "2024-01-10"}, b: Range{"2024-01-10", "2024-01-20"}, want: true},
+		{name: "adjacent", a: Range{"2024-01-01", "2024-01-10"}, b: Range{"2024-01-11", "2024-01-20"}, want: false},
+		{name: "disjoint", a: Range{"2024-01-01", "2024-01-10"}, b: Range{"2024-02-01", "2024-02-10"}, want: false},
+		{name: "containment", a: Range{"2024-01-01", "2024-01-31"}, b: Range{"2024-01-10", "2024-01-20"}, want: true},
+		{name: "partial", a: Range{"2024-01-01", "2024-01-15"}, b: Range{"2024-01-10", "2024-01-20"}, want: true},
+		{name: "reversed", a: Range{"2024-01-15", "2024-01-01"}, b: Range{"2024-01-10", "2024-01-20"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.a.Overlaps(tt.b))
+			assert.Equal(t, tt.want, tt.b.Overlaps(tt.a), "symmetric")
+		})
+	}
+}
+
+func TestRange_Intersection(t *testing.T) {
+	got, ok := Range{"2024-01-01", "2024-01-10"}.Intersection(Range{"2024-01-10", "2024-01-20"})
+	assert.True(t, ok, "touching")
+	assert.Equal(t, Range{From: "2024-01-10", Until: "2024-01-10"}, got, "touching")
+
+	got, ok = Range{"2024-01-01", "2024-01-31"}.Intersection(Range{"2024-01-10", "2024-01-20"})
+	assert.True(t, ok, "containment")
+	assert.Equal(t, Range{From: "2024-01-10", Until: "2024-01-20"}, got, "containment")
+
+	got, ok = Range{"2024-01-15", "2024-01-01"}.Intersection(Range{"2024-01-10", "2024-01-20"})
+	assert.True(t, ok, "reversed")
+	assert.Equal(t, Range{From: "2024-01-10", Until: "2024-01-15"}, got, "reversed")
+
+	_, ok = Range{"2024-01-01", "2024-01-10"}.Intersection(Range{"2024-01-11", "2024-01-20"})
+	assert.False(t, ok, "disjoint")
+}
+
+func TestRange_Union(t *testing.T) {
+	got, ok := Range{"2024-01-01", "2024-01-10"}.Union(Range{"2024-01-10", "2024-01-20"})
+	assert.True(t, ok, "touching")
+	assert.Equal(t, Range{From: "2024-01-01", Until: "2024-01-20"}, got, "touching")
+
+	got, ok = Range{"2024-01-11", "2024-01-20"}.Union(Range{"2024-01-01", "2024-01-10"})
+	assert.True(t, ok, "adjacent")
+	assert.Equal(t, Range{From: "2024-01-01", Until: "2024-01-20"}, got, "adjacent")
+
+	got, ok = Range{"2024-01-01", "2024-01-31"}.Union(Range{"2024-01-10", "2024-01-20"})
+	assert.True(t, ok, "containment")
+	assert.Equal(t, Range{From: "2024-01-01", Until: "2024-01-31"}, got, "containment")
+
+	_, ok = Range{"2024-01-01", "2024-01-10"}.Union(Range{"2024-01-12", "2024-01-20"})
+	assert.False(t, ok, "disjoint with gap")
+}
+
+func TestRange_Unbounded(t *testing.T) {
+	var open Range
+	require.NoError(t, open.Scan("[2023-01-01,)"))
+	assert.True(t, open.Contains("2024-06-01"), "unbounded until")
+	assert.True(t, open.Contains("2023-01-01"))
+	assert.False(t, open.Contains("2022-12-31"))
+
+	var openFrom Range
+	require.NoError(t, openFrom.Scan("(,2023-02-01)"))
+	assert.True(t, openFrom.Contains("1970-01-01"), "unbounded from")
+	assert.True(t, openFrom.Contains("2023-01-31"))
+	assert.False(t, openFrom.Contains("2023-02-01"))
+	assert.True(t, Range{}.Contains("2023-02-01"), "unbounded both sides")
+
+	assert.True(t, open.Overlaps(openFrom))
+	assert.True(t, open.Overlaps(Range{"2030-01-01", "2030-12-31"}))
+	assert.False(t, openFrom.Overlaps(Range{"2030-01-01", "2030-12-31"}))
+
+	got, ok := open.Intersection(openFrom)
+	assert.True(t, ok)
+	assert.Equal(t, Range{From: "2023-01-01", Until: "2023-01-31"}, got)
+	got, ok = open.Intersection(Range{"2022-01-01", "2023-06-30"})
+	assert.True(t, ok)
+	assert.Equal(t, Range{From: "2023-01-01", Until: "2023-06-30"}, got)
+
+	got, ok = open.Union(openFrom)
+	assert.True(t, ok)
+	assert.Equal(t, Range{}, got, "union is unbounded on both sides")
+	got, ok = open.Union(Range{"2022-01-01", "2022-12-31"})
+	assert.True(t, ok, "adjacent")
+	assert.Equal(t, Range{From: "2022-01-01"}, got)
+	_, ok = open.Union(Range{"2022-01-01", "2022-12-30"})
+	assert.False(t, ok, "gap")
+}
+
+func TestRange_SQL(t *testing.T) {
+	tests := []struct {
+		r   Range
+		sql string
+	}{
+		{r: Range{From: "2023-01-01", Until: "2023-01-31"}, sql: "[2023-01-01,2023-02-01)"},
+		{r: Range{From: "2023-12-31", Until: "2023-12-31"}, sql: "[2023-12-31,2024-01-01)"},
+		{r: Range{From: "2024-02-01", Until: "2024-02-29"}, sql: "[2024-02-01,2024-03-01)"},
+		{r: Range{From: "2023-01-01"}, sql: "[2023-01-01,)"},
+		{r: Range{Until: "2023-01-31"}, sql: "(,2023-02-01)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			value, err := tt.r.Value()
+			require.NoError(t, err)
+			assert.Equal(t, tt.sql, value)
+
+			var scanned Range
+			require.NoError(t, scanned.Scan(tt.sql))
+			assert.Equal(t, tt.r, scanned)
+			require.NoError(t, scanned.Scan([]byte(tt.sql)))
+			assert.Equal(t, tt.r, scanned)
+		})
+	}
+
+	var r Range
+	require.NoError(t, r.Scan("(2023-01-01,2023-01-31]"), "exclusive lower and inclusive upper bound")
+	assert.Equal(t, Range{From: "2023-01-02", Until: "2023-01-31"}, r)
+	require.NoError(t, r.Scan(nil))
+	assert.Equal(t, Range{}, r)
+
+	value, err := Range{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = Range{From: "2023-01-31", Until: "2023-01-01"}.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "[2023-01-01,2023-02-01)", value, "reversed range")
+	_, err = Range{From: "invalid", Until: "2023-01-01"}.Value()
+	assert.Error(t, err)
+
+	for _, invalid := range []string{"", "empty", "[2023-01-01]", "2023-01-01,2023-02-01", "[2023-13-01,2023-02-01)"} {
+		assert.Error(t, r.Scan(invalid), invalid)
+	}
+}
+
+func TestRange_JSON(t *testing.T) {
+	r := Range{From: "2023-01-01", Until: "2023-01-31"}
+	data, err := json.Marshal(r)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"from":"2023-01-01","until":"2023-01-31"}`, string(data))
+
+	var parsed Range
+	require.NoError(t, json.Unmarshal(data, &parsed))
+	assert.Equal(t, r, parsed)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"from":"2.1.2023","until":""}`), &parsed))
+	assert.Equal(t, Range{From: "2023-01-02"}, parsed, "normalized dates")
+
+	assert.Error(t, json.Unmarshal([]byte(`{"from":"not a date","until":"2023-01-31"}`), &parsed))
+	assert.Error(t, json.Unmarshal([]byte(`["2023-01-01","2023-01-31"]`), &parsed))
+}
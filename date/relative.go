@@ -0,0 +1,101 @@
+package date
+
+import (
+	"strconv"
+
+	"github.com/domonda/go-types/language"
+)
+
+// relativeDayNames holds the names for yesterday,
+// today, and tomorrow per language.
+var relativeDayNames = map[language.Code][3]string{
+	language.EN: {"yesterday", "today", "tomorrow"},
+	language.DE: {"gestern", "heute", "morgen"},
+}
+
+// relativeUnits holds the singular and plural unit names
+// for days, weeks, months, and years per language.
+// German uses the dative plural because the units
+// always follow "in" or "vor".
+var relativeUnits = map[language.Code][4][2]string{
+	language.EN: {{"day", "days"}, {"week", "weeks"}, {"month", "months"}, {"year", "years"}},
+	language.DE: {{"Tag", "Tagen"}, {"Woche", "Wochen"}, {"Monat", "Monaten"}, {"Jahr", "Jahren"}},
+}
+
+// RelativeString returns the date relative to now as
+// human readable text like "today", "yesterday", "tomorrow",
+// "in 3 days", or "2 months ago" in English
+// or "heute", "gestern", "morgen", "in 3 Tagen",
+// or "vor 2 Monaten" in German.
+// Other languages use English.
+//
+// Differences below 7 days are returned in days,
+// below one full calendar month in whole weeks,
+// below 12 full calendar months in whole months,
+// else in whole years, always rounded down.
+// So from 2024-01-15 to 2024-02-13 are 4 weeks,
+// and to 2024-02-15 is one month.
+// Full months are counted like PeriodBetween.
+//
+// An empty string is returned if date or now are not valid.
+func (date Date) RelativeString(now Date, lang language.Code) string {
+	date, err := date.Normalized()
+	if err != nil {
+		return ""
+	}
+	now, err = now.Normalized()
+	if err != nil {
+		return ""
+	}
+	lang, _ = lang.Normalized()
+	if lang != language.DE {
+		lang = language.EN
+	}
+
+	days := date.SubDays(now)
+	if days >= -1 && days <= 1 {
+		return relativeDayNames[lang][days+1]
+	}
+
+	var (
+		n    int
+		unit int
+	)
+	switch p := PeriodBetween(now, date); {
+	case days > -7 && days < 7:
+		n, unit = days, 0
+	case p.Years == 0 && p.Months == 0:
+		n, unit = days/7, 1
+	case p.Years == 0:
+		n, unit = p.Months, 2
+	default:
+		n, unit = p.Years, 3
+	}
+	future := n > 0
+	if !future {
+		n = -n
+	}
+	name := relativeUnits[lang][unit][1]
+	if n == 1 {
+		name = relativeUnits[lang][unit][0]
+	}
+	amount := strconv.Itoa(n) + " " + name
+	switch {
+	case future:
+		return "in " + amount
+	case lang == language.DE:
+		return "vor " + amount
+	default:
+		return amount + " ago"
+	}
+}
+
+// RelativeString returns the date relative to now as
+// human readable text or an empty string if the date is null.
+// See Date.RelativeString.
+func (n NullableDate) RelativeString(now Date, lang language.Code) string {
+	if n.IsNull() {
+		return ""
+	}
+	return Date(n).RelativeString(now, lang)
+}
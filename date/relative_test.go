@@ -0,0 +1,44 @@
+package date
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domonda/go-types/language"
+)
+
+func TestDate_RelativeString(t *testing.T) {
+	const now Date = "2024-01-15"
+	tests := []struct {
+		date Date
+		en   string
+		de   string
+	}{
+		{date: "2024-01-15", en: "today", de: "heute"},
+		{date: "2024-01-16", en: "tomorrow", de: "morgen"},
+		{date: "2024-01-14", en: "yesterday", de: "gestern"},
+		{date: "2024-01-18", en: "in 3 days", de: "in 3 Tagen"},
+		{date: "2024-01-09", en: "6 days ago", de: "vor 6 Tagen"},
+		{date: "2024-01-22", en: "in 1 week", de: "in 1 Woche"},
+		{date: "2024-02-13", en: "in 4 weeks", de: "in 4 Wochen"},
+		{date: "2024-02-15", en: "in 1 month", de: "in 1 Monat"},
+		{date: "2023-12-16", en: "4 weeks ago", de: "vor 4 Wochen"},
+		{date: "2023-11-15", en: "2 months ago", de: "vor 2 Monaten"},
+		{date: "2024-12-31", en: "in 11 months", de: "in 11 Monaten"},
+		{date: "2025-01-15", en: "in 1 year", de: "in 1 Jahr"},
+		{date: "2021-06-01", en: "2 years ago", de: "vor 2 Jahren"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.date), func(t *testing.T) {
+			assert.Equal(t, tt.en, tt.date.RelativeString(now, language.EN))
+			assert.Equal(t, tt.de, tt.date.RelativeString(now, language.DE))
+		})
+	}
+
+	assert.Equal(t, "in 3 days", Date("2024-01-18").RelativeString(now, language.FR), "English fallback")
+	assert.Equal(t, "", Date("invalid").RelativeString(now, language.EN))
+	assert.Equal(t, "", Date("2024-01-18").RelativeString("", language.EN))
+	assert.Equal(t, "", Null.RelativeString(now, language.EN))
+	assert.Equal(t, "gestern", NullableDate("2024-01-14").RelativeString(now, language.DE))
+}
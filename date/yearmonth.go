@@ -0,0 +1,220 @@
+package date
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/domonda/go-types/language"
+)
+
+// YearMonth is a month of a year in the format "YYYY-MM"
+// like "2023-06" as used by PeriodRange.
+type YearMonth string
+
+// YearMonthFrom returns the YearMonth for a year and month.
+func YearMonthFrom(year int, month time.Month) YearMonth {
+	return YearMonth(fmt.Sprintf("%04d-%02d", year, month))
+}
+
+// Valid returns if ym is in the format "YYYY-MM"
+// with a month from 1 to 12.
+func (ym YearMonth) Valid() bool {
+	return ym.Validate() == nil
+}
+
+// Validate returns an error if ym is not in the format "YYYY-MM"
+// with a month from 1 to 12.
+func (ym YearMonth) Validate() error {
+	if len(ym) != 7 || ym[4] != '-' || !isDigits(string(ym[:4])) || !isDigits(string(ym[5:])) {
+		return fmt.Errorf("invalid date.YearMonth: %q", string(ym))
+	}
+	if month, _ := strconv.Atoi(string(ym[5:])); month < 1 || month > 12 {
+		return fmt.Errorf("invalid date.YearMonth: %q", string(ym))
+	}
+	return nil
+}
+
+// Year returns the year of the YearMonth
+// or zero if it is not valid.
+func (ym YearMonth) Year() int {
+	if !ym.Valid() {
+		return 0
+	}
+	year, _ := strconv.Atoi(string(ym[:4]))
+	return year
+}
+
+// Month returns the month of the YearMonth
+// or zero if it is not valid.
+func (ym YearMonth) Month() time.Month {
+	if !ym.Valid() {
+		return 0
+	}
+	month, _ := strconv.Atoi(string(ym[5:]))
+	return time.Month(month)
+}
+
+// DateRange returns the first and last date of the month.
+func (ym YearMonth) DateRange() (from, until Date, err error) {
+	if err = ym.Validate(); err != nil {
+		return "", "", err
+	}
+	year, month := ym.Year(), ym.Month()
+	from = Of(year, month, 1)
+	until = Of(year, month+1, 0) // 0th day is the last day of the previous month
+	return from, until, nil
+}
+
+// Label returns the month name in the passed language
+// followed by the year like "June 2023" in English
+// or "Juni 2023" in German for display and file names.
+// Unsupported languages use English month names.
+// An empty string is returned if ym is not valid.
+func (ym YearMonth) Label(lang language.Code) string {
+	if !ym.Valid() {
+		return ""
+	}
+	return longMonthName(ym.Month(), lang) + " " + string(ym[:4])
+}
+
+// Compare returns -1 if ym is before other,
+// +1 if ym is after other, or 0 if they are equal.
+func (ym YearMonth) Compare(other YearMonth) int {
+	return strings.Compare(string(ym), string(other))
+}
+
+// AddMonths returns the YearMonth with the passed
+// number of months added, crossing year boundaries
+// like "2023-12" plus one month is "2024-01".
+// An invalid ym is returned unchanged.
+func (ym YearMonth) AddMonths(months int) YearMonth {
+	if !ym.Valid() {
+		return ym
+	}
+	return yearMonthOfIndex(ym.index() + months)
+}
+
+// index returns the number of months since year zero
+// for iterating over months without formatting
+// and comparing invalid strings beyond year 9999.
+func (ym YearMonth) index() int {
+	return ym.Year()*12 + int(ym.Month()) - 1
+}
+
+func yearMonthOfIndex(index int) YearMonth {
+	return YearMonthFrom(index/12, time.Month(index%12+1))
+}
+
+// Until returns all months from ym until the passed month
+// including both, see YearMonthsInRange.
+func (ym YearMonth) Until(until YearMonth) []YearMonth {
+	return YearMonthsInRange(ym, until)
+}
+
+// YearMonthsInRange returns all months between from and until
+// including both, so equal from and until
+// return a single month.
+// Nil is returned if from is after until
+// or if from or until are not valid.
+func YearMonthsInRange(from, until YearMonth) []YearMonth {
+	return slices.Collect(YearMonthsInRangeSeq(from, until))
+}
+
+// YearMonthsInRangeSeq returns an iterator over all months
+// between from and until including both.
+// Nothing is yielded if from is after until
+// or if from or until are not valid.
+func YearMonthsInRangeSeq(from, until YearMonth) iter.Seq[YearMonth] {
+	return func(yield func(YearMonth) bool) {
+		if !from.Valid() || !until.Valid() {
+			return
+		}
+		for i := from.index(); i <= until.index(); i++ {
+			if !yield(yearMonthOfIndex(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Nullable returns the YearMonth as NullableYearMonth.
+func (ym YearMonth) Nullable() NullableYearMonth {
+	return NullableYearMonth(ym)
+}
+
+// String implements the fmt.Stringer interface.
+func (ym YearMonth) String() string {
+	return string(ym)
+}
+
+// YearMonthNull is the null value of NullableYearMonth.
+const YearMonthNull NullableYearMonth = ""
+
+// NullableYearMonth is a YearMonth
+// where an empty string represents null.
+type NullableYearMonth string
+
+// IsNull returns true if the NullableYearMonth is null.
+// IsNull implements the nullable.Nullable interface.
+func (n NullableYearMonth) IsNull() bool {
+	return n == YearMonthNull
+}
+
+// IsNotNull returns true if the NullableYearMonth is not null.
+func (n NullableYearMonth) IsNotNull() bool {
+	return n != YearMonthNull
+}
+
+// Valid returns if n is null or a valid YearMonth.
+func (n NullableYearMonth) Valid() bool {
+	return n.IsNull() || YearMonth(n).Valid()
+}
+
+// Get returns the non nullable YearMonth value
+// or panics if the NullableYearMonth is null.
+// Note: check with IsNull before using Get!
+func (n NullableYearMonth) Get() YearMonth {
+	if n.IsNull() {
+		panic("NULL date.YearMonth")
+	}
+	return YearMonth(n)
+}
+
+// DateRange returns the first and last date of the month
+// or null dates if n is null.
+func (n NullableYearMonth) DateRange() (from, until NullableDate, err error) {
+	if n.IsNull() {
+		return Null, Null, nil
+	}
+	f, u, err := YearMonth(n).DateRange()
+	if err != nil {
+		return Null, Null, err
+	}
+	return f.Nullable(), u.Nullable(), nil
+}
+
+// Label returns the result of YearMonth.Label
+// or an empty string if n is null.
+func (n NullableYearMonth) Label(lang language.Code) string {
+	return YearMonth(n).Label(lang)
+}
+
+// Compare returns -1 if n is before other,
+// +1 if n is after other, or 0 if they are equal.
+// Null is before any non null value.
+func (n NullableYearMonth) Compare(other NullableYearMonth) int {
+	return strings.Compare(string(n), string(other))
+}
+
+// String returns the YearMonth or "NULL".
+// String implements the fmt.Stringer interface.
+func (n NullableYearMonth) String() string {
+	if n.IsNull() {
+		return "NULL"
+	}
+	return string(n)
+}
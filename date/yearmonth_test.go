@@ -0,0 +1,118 @@
+package date
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domonda/go-types/language"
+)
+
+func TestYearMonth(t *testing.T) {
+	ym := YearMonthFrom(2023, time.June)
+	assert.Equal(t, YearMonth("2023-06"), ym)
+	assert.True(t, ym.Valid())
+	assert.Equal(t, 2023, ym.Year())
+	assert.Equal(t, time.June, ym.Month())
+	from, until, err := ym.DateRange()
+	assert.NoError(t, err)
+	assert.Equal(t, Date("2023-06-01"), from)
+	assert.Equal(t, Date("2023-06-30"), until)
+	assert.Equal(t, -1, ym.Compare("2023-07"))
+	assert.Equal(t, +1, ym.Compare("2022-12"))
+
+	for _, invalid := range []YearMonth{"", "2023", "2023-00", "2023-13", "2023-6", "23-06", "2023/06"} {
+		assert.False(t, invalid.Valid(), "%q", invalid)
+		assert.Zero(t, invalid.Month(), "%q", invalid)
+		_, _, err := invalid.DateRange()
+		assert.Error(t, err, "%q", invalid)
+	}
+
+	assert.True(t, YearMonthNull.Valid())
+	assert.Equal(t, -1, YearMonthNull.Compare("2023-01"))
+	assert.Equal(t, "NULL", YearMonthNull.String())
+}
+
+func TestYearMonth_Label(t *testing.T) {
+	assert.Equal(t, "June 2023", YearMonth("2023-06").Label(language.EN))
+	assert.Equal(t, "Juni 2023", YearMonth("2023-06").Label(language.DE))
+	assert.Equal(t, "März 2024", YearMonth("2024-03").Label("de"))
+	assert.Equal(t, "December 2024", YearMonth("2024-12").Label(""), "English as default")
+	assert.Equal(t, "", YearMonth("2023-13").Label(language.EN))
+	assert.Equal(t, "", YearMonthNull.Label(language.EN))
+	assert.Equal(t, "Juni 2023", NullableYearMonth("2023-06").Label(language.DE))
+}
+
+func TestYearQuarter_Label(t *testing.T) {
+	assert.Equal(t, "Q2 2023", YearQuarter("2023-Q2").Label(language.EN))
+	assert.Equal(t, "Q2 2023", YearQuarter("2023-Q2").Label(language.DE))
+	assert.Equal(t, "", YearQuarter("2023-Q5").Label(language.EN))
+	assert.Equal(t, "", YearQuarterNull.Label(language.EN))
+}
+
+func TestNullableYearMonth_DateRange(t *testing.T) {
+	tests := []struct {
+		ym    NullableYearMonth
+		from  NullableDate
+		until NullableDate
+	}{
+		{ym: "2023-03", from: "2023-03-01", until: "2023-03-31"},
+		{ym: "2023-04", from: "2023-04-01", until: "2023-04-30"},
+		{ym: "2023-02", from: "2023-02-01", until: "2023-02-28"},
+		{ym: "2024-02", from: "2024-02-01", until: "2024-02-29"},
+		{ym: "2023-12", from: "2023-12-01", until: "2023-12-31"},
+		{ym: YearMonthNull, from: Null, until: Null},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.ym), func(t *testing.T) {
+			from, until, err := tt.ym.DateRange()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.from, from)
+			assert.Equal(t, tt.until, until)
+			if tt.ym.IsNotNull() {
+				f, u, err := tt.ym.Get().DateRange()
+				assert.NoError(t, err)
+				assert.Equal(t, tt.from.Get(), f)
+				assert.Equal(t, tt.until.Get(), u)
+			}
+		})
+	}
+
+	_, _, err := NullableYearMonth("2023-13").DateRange()
+	assert.Error(t, err)
+}
+
+func TestYearMonth_AddMonths(t *testing.T) {
+	assert.Equal(t, YearMonth("2023-07"), YearMonth("2023-06").AddMonths(1))
+	assert.Equal(t, YearMonth("2024-01"), YearMonth("2023-12").AddMonths(1))
+	assert.Equal(t, YearMonth("2022-12"), YearMonth("2023-01").AddMonths(-1))
+	assert.Equal(t, YearMonth("2025-06"), YearMonth("2023-06").AddMonths(24))
+	assert.Equal(t, YearMonth("2023-06"), YearMonth("2023-06").AddMonths(0))
+	assert.Equal(t, YearMonth("invalid"), YearMonth("invalid").AddMonths(1))
+}
+
+func TestYearMonthsInRange(t *testing.T) {
+	assert.Equal(t,
+		[]YearMonth{"2023-11", "2023-12", "2024-01", "2024-02"},
+		YearMonthsInRange("2023-11", "2024-02"),
+		"across year boundary",
+	)
+	assert.Equal(t, []YearMonth{"2023-06"}, YearMonthsInRange("2023-06", "2023-06"), "single month")
+	assert.Nil(t, YearMonthsInRange("2024-02", "2023-11"), "from after until")
+	assert.Nil(t, YearMonthsInRange("", "2023-11"), "invalid from")
+	assert.Nil(t, YearMonthsInRange("2023-11", "2023-13"), "invalid until")
+	assert.Equal(t, YearMonthsInRange("2023-01", "2023-12"), YearMonth("2023-01").Until("2023-12"))
+	assert.Equal(t, []YearMonth{"9999-11", "9999-12"}, YearMonthsInRange("9999-11", "9999-12"), "last valid year")
+	assert.Equal(t, []YearMonth{"0000-01", "0000-02"}, YearMonthsInRange("0000-01", "0000-02"), "first valid year")
+	assert.Len(t, YearMonth("2023-01").Until("2023-12"), 12)
+
+	var first []YearMonth
+	for ym := range YearMonthsInRangeSeq("2023-11", "2024-02") {
+		first = append(first, ym)
+		if len(first) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []YearMonth{"2023-11", "2023-12"}, first, "stop iteration")
+}
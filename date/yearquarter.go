@@ -0,0 +1,142 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/domonda/go-types/language"
+)
+
+// YearQuarter is a quarter of a year in the format "YYYY-Qn"
+// like "2023-Q2" as used by PeriodRange.
+type YearQuarter string
+
+// YearQuarterFrom returns the YearQuarter for a year and quarter 1 to 4.
+func YearQuarterFrom(year, quarter int) YearQuarter {
+	return YearQuarter(fmt.Sprintf("%04d-Q%d", year, quarter))
+}
+
+// Valid returns if yq is in the format "YYYY-Qn"
+// with a quarter from 1 to 4.
+func (yq YearQuarter) Valid() bool {
+	return yq.Validate() == nil
+}
+
+// Validate returns an error if yq is not in the format "YYYY-Qn"
+// with a quarter from 1 to 4.
+func (yq YearQuarter) Validate() error {
+	if len(yq) != 7 || yq[4] != '-' || yq[5] != 'Q' || !isDigits(string(yq[:4])) || yq[6] < '1' || yq[6] > '4' {
+		return fmt.Errorf("invalid date.YearQuarter: %q", string(yq))
+	}
+	return nil
+}
+
+// Year returns the year of the YearQuarter
+// or zero if it is not valid.
+func (yq YearQuarter) Year() int {
+	if !yq.Valid() {
+		return 0
+	}
+	year, _ := strconv.Atoi(string(yq[:4]))
+	return year
+}
+
+// Quarter returns the quarter from 1 to 4
+// or zero if the YearQuarter is not valid.
+func (yq YearQuarter) Quarter() int {
+	if !yq.Valid() {
+		return 0
+	}
+	return int(yq[6] - '0')
+}
+
+// DateRange returns the first and last date of the quarter.
+func (yq YearQuarter) DateRange() (from, until Date, err error) {
+	if err = yq.Validate(); err != nil {
+		return "", "", err
+	}
+	return PeriodRange(string(yq))
+}
+
+// Label returns the quarter followed by the year
+// like "Q2 2023" for display and file names.
+// The label is the same for all languages.
+// An empty string is returned if yq is not valid.
+func (yq YearQuarter) Label(lang language.Code) string {
+	if !yq.Valid() {
+		return ""
+	}
+	return "Q" + string(yq[6]) + " " + string(yq[:4])
+}
+
+// Compare returns -1 if yq is before other,
+// +1 if yq is after other, or 0 if they are equal.
+func (yq YearQuarter) Compare(other YearQuarter) int {
+	return strings.Compare(string(yq), string(other))
+}
+
+// Nullable returns the YearQuarter as NullableYearQuarter.
+func (yq YearQuarter) Nullable() NullableYearQuarter {
+	return NullableYearQuarter(yq)
+}
+
+// String implements the fmt.Stringer interface.
+func (yq YearQuarter) String() string {
+	return string(yq)
+}
+
+// YearQuarterNull is the null value of NullableYearQuarter.
+const YearQuarterNull NullableYearQuarter = ""
+
+// NullableYearQuarter is a YearQuarter
+// where an empty string represents null.
+type NullableYearQuarter string
+
+// IsNull returns true if the NullableYearQuarter is null.
+// IsNull implements the nullable.Nullable interface.
+func (n NullableYearQuarter) IsNull() bool {
+	return n == YearQuarterNull
+}
+
+// IsNotNull returns true if the NullableYearQuarter is not null.
+func (n NullableYearQuarter) IsNotNull() bool {
+	return n != YearQuarterNull
+}
+
+// Valid returns if n is null or a valid YearQuarter.
+func (n NullableYearQuarter) Valid() bool {
+	return n.IsNull() || YearQuarter(n).Valid()
+}
+
+// Get returns the non nullable YearQuarter value
+// or panics if the NullableYearQuarter is null.
+// Note: check with IsNull before using Get!
+func (n NullableYearQuarter) Get() YearQuarter {
+	if n.IsNull() {
+		panic("NULL date.YearQuarter")
+	}
+	return YearQuarter(n)
+}
+
+// Label returns the result of YearQuarter.Label
+// or an empty string if n is null.
+func (n NullableYearQuarter) Label(lang language.Code) string {
+	return YearQuarter(n).Label(lang)
+}
+
+// Compare returns -1 if n is before other,
+// +1 if n is after other, or 0 if they are equal.
+// Null is before any non null value.
+func (n NullableYearQuarter) Compare(other NullableYearQuarter) int {
+	return strings.Compare(string(n), string(other))
+}
+
+// String returns the YearQuarter or "NULL".
+// String implements the fmt.Stringer interface.
+func (n NullableYearQuarter) String() string {
+	if n.IsNull() {
+		return "NULL"
+	}
+	return string(n)
+}
@@ -0,0 +1,58 @@
+package date
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYearQuarter(t *testing.T) {
+	yq := YearQuarterFrom(2023, 2)
+	assert.Equal(t, YearQuarter("2023-Q2"), yq)
+	assert.True(t, yq.Valid())
+	assert.Equal(t, 2023, yq.Year())
+	assert.Equal(t, 2, yq.Quarter())
+	from, until, err := yq.DateRange()
+	assert.NoError(t, err)
+	assert.Equal(t, Date("2023-04-01"), from)
+	assert.Equal(t, Date("2023-06-30"), until)
+	assert.Equal(t, -1, yq.Compare("2023-Q3"))
+	assert.Equal(t, +1, yq.Compare("2022-Q4"))
+
+	for _, invalid := range []YearQuarter{"", "2023", "2023-Q0", "2023-Q5", "2023-q2", "23-Q2"} {
+		assert.False(t, invalid.Valid(), "%q", invalid)
+		assert.Zero(t, invalid.Quarter(), "%q", invalid)
+		_, _, err := invalid.DateRange()
+		assert.Error(t, err, "%q", invalid)
+	}
+
+	assert.True(t, YearQuarterNull.Valid())
+	assert.Equal(t, -1, YearQuarterNull.Compare("2023-Q1"))
+	assert.Equal(t, "NULL", YearQuarterNull.String())
+}
+
+func TestDate_Quarter(t *testing.T) {
+	tests := []struct {
+		date        Date
+		quarter     int
+		yearQuarter YearQuarter
+	}{
+		{"2023-01-01", 1, "2023-Q1"},
+		{"2023-03-31", 1, "2023-Q1"},
+		{"2023-04-01", 2, "2023-Q2"},
+		{"2023-09-30", 3, "2023-Q3"},
+		{"2023-12-31", 4, "2023-Q4"},
+		{"31.12.2023", 4, "2023-Q4"},
+		{"", 0, ""},
+		{"invalid", 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.date), func(t *testing.T) {
+			assert.Equal(t, tt.quarter, tt.date.Quarter())
+			assert.Equal(t, tt.yearQuarter, tt.date.YearQuarter())
+			assert.Equal(t, tt.quarter, NullableDate(tt.date).Quarter())
+			assert.Equal(t, NullableYearQuarter(tt.yearQuarter), NullableDate(tt.date).YearQuarter())
+		})
+	}
+	assert.Equal(t, YearQuarterNull, Null.YearQuarter())
+}
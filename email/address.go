@@ -1,8 +1,11 @@
 package email
 
 import (
+	"fmt"
 	"net/mail"
 	"strings"
+
+	"golang.org/x/net/idna"
 )
 
 // Address is a string containing a non-normalized email-address
@@ -41,6 +44,16 @@ func (a Address) Normalized() (Address, error) {
 	return AddressFrom(parsed), nil
 }
 
+// NormalizedOrUnchanged returns the result of Normalized
+// or the unchanged address in case of an error.
+func (a Address) NormalizedOrUnchanged() Address {
+	norm, err := a.Normalized()
+	if err != nil {
+		return a
+	}
+	return norm
+}
+
 // Parse the Address as *mail.Address less strict than
 // the standard net/mail.ParseAddress function
 // fixing malformed addresses and lower cases the address part.
@@ -91,6 +104,40 @@ func (a Address) LocalPart() (string, error) {
 	return parsed.Address[:strings.IndexByte(parsed.Address, '@')], nil
 }
 
+// IsRoleAddress returns true if the local part of the address
+// without a "+" sub-address tag is contained in RoleLocalParts,
+// like "info@example.com" or "noreply+bounce@example.com".
+// Returns false if the address can't be parsed.
+func (a Address) IsRoleAddress() bool {
+	local, err := a.LocalPart()
+	if err != nil {
+		return false
+	}
+	local, _, _ = strings.Cut(strings.ToLower(local), "+")
+	_, ok := RoleLocalParts[local]
+	return ok
+}
+
+// IsDisposableDomain returns true if the domain of the
+// normalized address part or one of its parent domains
+// is contained in DisposableDomains,
+// like "someone@mailinator.com".
+// Returns false if the address can't be parsed.
+func (a Address) IsDisposableDomain() bool {
+	addr, err := a.AddressPartString()
+	if err != nil {
+		return false
+	}
+	domain := addr[strings.LastIndexByte(addr, '@')+1:]
+	for domain != "" {
+		if _, ok := DisposableDomains[domain]; ok {
+			return true
+		}
+		_, domain, _ = strings.Cut(domain, ".")
+	}
+	return false
+}
+
 // DomainPart returns the part of the address after the @ character
 // or an empty string in case it can't be parsed.
 func (a Address) DomainPart() string {
@@ -99,6 +146,44 @@ func (a Address) DomainPart() string {
 	return strings.TrimRight(s, "> \t\r\n")
 }
 
+// ASCIIDomain returns the domain of the normalized address part
+// converted to its ASCII compatible IDNA form,
+// like "xn--mller-kva.de" for "someone@müller.de",
+// as required to deliver to internationalized domains via SMTP.
+// ASCII domains are returned lower case but otherwise unchanged.
+func (a Address) ASCIIDomain() (string, error) {
+	addr, err := a.AddressPartString()
+	if err != nil {
+		return "", err
+	}
+	domain := addr[strings.LastIndexByte(addr, '@')+1:]
+	ascii, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", fmt.Errorf("can't convert domain of email address %q to ASCII: %w", string(a), err)
+	}
+	return ascii, nil
+}
+
+// UnicodeForm returns the normalized address with
+// the domain converted from its ASCII compatible IDNA form
+// to Unicode, like "someone@müller.de" for "someone@xn--mller-kva.de".
+// The name part is kept.
+// The address is returned unchanged if it can't be parsed
+// or its domain can't be converted.
+func (a Address) UnicodeForm() Address {
+	parsed, err := a.Parse()
+	if err != nil {
+		return a
+	}
+	at := strings.LastIndexByte(parsed.Address, '@')
+	domain, err := idna.Lookup.ToUnicode(parsed.Address[at+1:])
+	if err != nil {
+		return a
+	}
+	parsed.Address = parsed.Address[:at+1] + domain
+	return AddressFrom(parsed)
+}
+
 func (a Address) AsList() AddressList {
 	return AddressList(a)
 }
@@ -44,3 +44,80 @@ func TestAddressFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestAddress_IsRoleAddress(t *testing.T) {
+	tests := []struct {
+		addr Address
+		want bool
+	}{
+		{addr: "noreply@x.com", want: true},
+		{addr: "No-Reply@X.com", want: true},
+		{addr: `"Billing" <billing@example.com>`, want: true},
+		{addr: "postmaster+bounce@example.com", want: true},
+		{addr: "erik@domonda.com", want: false},
+		{addr: "information@example.com", want: false},
+		{addr: "", want: false},
+		{addr: "not an address", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.addr), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.addr.IsRoleAddress())
+		})
+	}
+}
+
+func TestAddress_IsDisposableDomain(t *testing.T) {
+	assert.NotNil(t, DisposableDomains)
+	assert.True(t, Address("someone@mailinator.com").IsDisposableDomain())
+	assert.True(t, Address(`"Some One" <Someone@MAILINATOR.com>`).IsDisposableDomain())
+	assert.True(t, Address("someone@inbox.mailinator.com").IsDisposableDomain(), "subdomain")
+	assert.False(t, Address("erik@domonda.com").IsDisposableDomain())
+	assert.False(t, Address("mailinator.com@domonda.com").IsDisposableDomain())
+	assert.False(t, Address("not an address").IsDisposableDomain())
+
+	defaultDomains := DisposableDomains
+	defer func() { DisposableDomains = defaultDomains }()
+
+	SetDisposableDomains([]string{" Example.COM ", ""})
+	assert.Len(t, DisposableDomains, 1)
+	assert.True(t, Address("someone@example.com").IsDisposableDomain())
+	assert.False(t, Address("someone@mailinator.com").IsDisposableDomain())
+}
+
+func TestAddress_ASCIIDomain(t *testing.T) {
+	tests := []struct {
+		addr    Address
+		want    string
+		wantErr bool
+	}{
+		{addr: "someone@müller.de", want: "xn--mller-kva.de"},
+		{addr: "Someone <someone@MÜLLER.de>", want: "xn--mller-kva.de"},
+		{addr: "someone@xn--mller-kva.de", want: "xn--mller-kva.de"},
+		{addr: "someone@example.com", want: "example.com"},
+		{addr: "invalid", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.addr), func(t *testing.T) {
+			got, err := tt.addr.ASCIIDomain()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAddress_UnicodeForm(t *testing.T) {
+	assert.Equal(t, Address("someone@müller.de"), Address("someone@xn--mller-kva.de").UnicodeForm())
+	assert.Equal(t, Address(`"Some One" <someone@müller.de>`), Address("Some One <someone@xn--mller-kva.de>").UnicodeForm())
+	assert.Equal(t, Address("someone@müller.de"), Address("someone@müller.de").UnicodeForm())
+	assert.Equal(t, Address("someone@example.com"), Address("someone@example.com").UnicodeForm())
+	assert.Equal(t, Address("invalid"), Address("invalid").UnicodeForm())
+
+	// Round-trip
+	ascii, err := Address("someone@müller.de").ASCIIDomain()
+	assert.NoError(t, err)
+	assert.Equal(t, Address("someone@müller.de"), Address("someone@"+ascii).UnicodeForm())
+}
@@ -71,6 +71,26 @@ func AddressListJoinStrings(addrs ...string) AddressList {
 	return AddressList(b.String())
 }
 
+// JoinAddressList returns a comma separated AddressList
+// of the passed addresses in the canonical form of Address.Normalized
+// with names quoted and RFC 2047 encoded as required.
+// Empty addresses are skipped and addresses that
+// can't be normalized are joined unchanged.
+// JoinAddressList is the inverse of AddressList.Split.
+func JoinAddressList(addrs []Address) AddressList {
+	var b strings.Builder
+	for _, addr := range addrs {
+		if len(addr) == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(string(addr.NormalizedOrUnchanged()))
+	}
+	return AddressList(b.String())
+}
+
 func (l AddressList) Append(addrs ...Address) AddressList {
 	var b strings.Builder
 	b.WriteString(string(l))
@@ -90,6 +110,9 @@ func (l AddressList) Parse() ([]*mail.Address, error) {
 	return ParseAddressList(string(l))
 }
 
+// Split returns the normalized addresses of the list.
+// Every split address is validated so that
+// JoinAddressList(split) re-creates a parseable list.
 func (l AddressList) Split() ([]Address, error) {
 	parsed, err := l.Parse()
 	if err != nil {
@@ -101,6 +124,9 @@ func (l AddressList) Split() ([]Address, error) {
 	a := make([]Address, len(parsed))
 	for i, p := range parsed {
 		a[i] = AddressFrom(p)
+		if err = a[i].Validate(); err != nil {
+			return nil, fmt.Errorf("address %q split from list is invalid: %w", a[i], err)
+		}
 	}
 	return a, nil
 }
@@ -120,6 +146,67 @@ func (l AddressList) UniqueAddressParts() (AddressSet, error) {
 	return set, nil
 }
 
+// Deduplicated returns the list with only the first
+// of multiple addresses with the same normalized address part,
+// ignoring different name parts.
+// The list is returned unchanged if it can't be parsed.
+func (l AddressList) Deduplicated() AddressList {
+	parsed, err := l.Parse()
+	if err != nil {
+		return l
+	}
+	appended := make(map[string]bool, len(parsed))
+	addrs := make([]Address, 0, len(parsed))
+	for _, p := range parsed {
+		if appended[p.Address] {
+			continue
+		}
+		addrs = append(addrs, AddressFrom(p))
+		appended[p.Address] = true
+	}
+	return JoinAddressList(addrs)
+}
+
+// Contains returns true if the list contains an address
+// with the same normalized address part as addr,
+// ignoring the name parts.
+func (l AddressList) Contains(addr Address) bool {
+	addrPart, err := addr.AddressPartString()
+	if err != nil {
+		return false
+	}
+	parsed, err := l.Parse()
+	if err != nil {
+		return false
+	}
+	for _, p := range parsed {
+		if p.Address == addrPart {
+			return true
+		}
+	}
+	return false
+}
+
+// Without returns the list without the addresses
+// that have the same normalized address part as
+// an address of other, ignoring the name parts.
+// The list is returned unchanged if it can't be parsed
+// and an unparseable other list is treated as empty.
+func (l AddressList) Without(other AddressList) AddressList {
+	parsed, err := l.Parse()
+	if err != nil {
+		return l
+	}
+	exclude, _ := other.UniqueAddressParts()
+	addrs := make([]Address, 0, len(parsed))
+	for _, p := range parsed {
+		if !exclude.Contains(Address(p.Address)) {
+			addrs = append(addrs, AddressFrom(p))
+		}
+	}
+	return JoinAddressList(addrs)
+}
+
 func (l AddressList) Validate() error {
 	_, err := l.Parse()
 	return err
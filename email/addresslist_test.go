@@ -55,3 +55,75 @@ func TestAddressList_Split(t *testing.T) {
 		})
 	}
 }
+
+func TestJoinAddressList(t *testing.T) {
+	tests := []struct {
+		addrs []Address
+		want  AddressList
+	}{
+		{addrs: nil, want: ``},
+		{addrs: []Address{``, `hello@example.com`}, want: `hello@example.com`},
+		{addrs: []Address{`Hello@Example.com`, `"Unger, Erik" <U.Erik@domonda.com>`}, want: `hello@example.com, "Unger, Erik" <u.erik@domonda.com>`},
+		{addrs: []Address{`Jürgen Müller <jm@example.com>`}, want: `=?utf-8?q?J=C3=BCrgen_M=C3=BCller?= <jm@example.com>`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.want), func(t *testing.T) {
+			assert.Equal(t, tt.want, JoinAddressList(tt.addrs))
+		})
+	}
+}
+
+func TestAddressList_SplitJoin(t *testing.T) {
+	lists := []AddressList{
+		`hello@example.com`,
+		`"Unger, Erik" <u.erik@domonda.com>, "Doe, Jane" <jane@example.com>`,
+		`"Doe, Jane, Dr." <jane@example.com>, world@example.com`,
+		`=?utf-8?q?J=C3=BCrgen_M=C3=BCller?= <jm@example.com>`,
+	}
+	for _, l := range lists {
+		t.Run(string(l), func(t *testing.T) {
+			split, err := l.Split()
+			assert.NoError(t, err)
+			joined := JoinAddressList(split)
+			assert.Equal(t, l, joined, "stable round-trip")
+			splitAgain, err := joined.Split()
+			assert.NoError(t, err)
+			assert.Equal(t, split, splitAgain)
+		})
+	}
+}
+
+func TestAddressList_Deduplicated(t *testing.T) {
+	tests := []struct {
+		l    AddressList
+		want AddressList
+	}{
+		{l: ``, want: ``},
+		{l: `hello@example.com`, want: `hello@example.com`},
+		{l: `hello@example.com, Hello@Example.com`, want: `hello@example.com`},
+		{l: `"Doe, Jane" <jane@example.com>, Jane Doe <JANE@example.com>, world@example.com`, want: `"Doe, Jane" <jane@example.com>, world@example.com`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.l), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.l.Deduplicated())
+		})
+	}
+}
+
+func TestAddressList_Contains(t *testing.T) {
+	l := AddressList(`"Doe, Jane" <jane@example.com>, world@example.com`)
+	assert.True(t, l.Contains(`jane@example.com`))
+	assert.True(t, l.Contains(`Jane Doe <JANE@Example.com>`), "different name part")
+	assert.True(t, l.Contains(`World <world@example.com>`))
+	assert.False(t, l.Contains(`john@example.com`))
+	assert.False(t, l.Contains(``))
+	assert.False(t, AddressList(``).Contains(`jane@example.com`))
+}
+
+func TestAddressList_Without(t *testing.T) {
+	l := AddressList(`"Doe, Jane" <jane@example.com>, world@example.com, John <john@example.com>`)
+	assert.Equal(t, AddressList(`world@example.com, "John" <john@example.com>`), l.Without(`Jane Doe <Jane@example.com>`))
+	assert.Equal(t, AddressList(`"Doe, Jane" <jane@example.com>`), l.Without(`World@example.com, John Smith <john@example.com>`))
+	assert.Equal(t, AddressList(``), l.Without(l))
+	assert.Equal(t, l.Deduplicated(), l.Without(``))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path"
+	"strings"
 
 	"github.com/domonda/go-types/uu"
 	"github.com/ungerik/go-fs"
@@ -23,16 +25,19 @@ type Attachment struct {
 	fs.MemFile
 }
 
+// NewAttachment returns a new Attachment with a random ContentID
+// and the ContentType from DetectContentType.
 func NewAttachment(partID, filename string, content []byte) *Attachment {
-	return &Attachment{
-		PartID:      partID,
-		ContentID:   uu.IDv4().Hex(),
-		ContentType: http.DetectContentType(content),
+	a := &Attachment{
+		PartID:    partID,
+		ContentID: uu.IDv4().Hex(),
 		MemFile: fs.MemFile{
 			FileName: filename,
 			FileData: content,
 		},
 	}
+	a.ContentType = a.DetectContentType()
+	return a
 }
 
 func NewAttachmentReadFile(ctx context.Context, partID string, file fs.FileReader) (*Attachment, error) {
@@ -43,6 +48,44 @@ func NewAttachmentReadFile(ctx context.Context, partID string, file fs.FileReade
 	return NewAttachment(partID, file.Name(), data), nil
 }
 
+// contentTypeByExtension is used by Attachment.DetectContentType
+// for file types that http.DetectContentType can't identify
+// or identifies as their container format like
+// application/zip for Office Open XML files.
+var contentTypeByExtension = map[string]string{
+	".pdf":  "application/pdf",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".xls":  "application/vnd.ms-excel",
+	".doc":  "application/msword",
+	".ppt":  "application/vnd.ms-powerpoint",
+	".odt":  "application/vnd.oasis.opendocument.text",
+	".ods":  "application/vnd.oasis.opendocument.spreadsheet",
+	".csv":  "text/csv",
+	".xml":  "application/xml",
+	".json": "application/json",
+}
+
+// DetectContentType returns the MIME type of the attachment
+// sniffed from its content with http.DetectContentType.
+// If the sniffed type is generic like application/octet-stream,
+// application/zip, or text/plain, then the type for
+// the file extension is returned if it is known.
+func (a *Attachment) DetectContentType() string {
+	sniffed := http.DetectContentType(a.FileData)
+	switch {
+	case sniffed == "application/octet-stream",
+		sniffed == "application/zip",
+		strings.HasPrefix(sniffed, "text/plain"):
+		ext := strings.ToLower(path.Ext(a.FileName))
+		if contentType, ok := contentTypeByExtension[ext]; ok {
+			return contentType
+		}
+	}
+	return sniffed
+}
+
 func (a *Attachment) String() string {
 	return fmt.Sprintf("Attachment{ID: `%s`, File: `%s`, Size: %d}", a.PartID, a.FileName, len(a.FileData))
 }
@@ -21,3 +21,28 @@ func TestAttachment_MarshalJSON(t *testing.T) {
 	require.NoError(t, err, "json.Marshal")
 	require.Equal(t, `{"partID":"PartID","contentID":"ContentID","contentType":"ContentType","filename":"FileName","data":"RmlsZURhdGE="}`, string(j))
 }
+
+func TestAttachment_DetectContentType(t *testing.T) {
+	zipHeader := []byte("PK\x03\x04\x14\x00\x06\x00\x08\x00\x00\x00!\x00")
+	tests := []struct {
+		filename string
+		content  []byte
+		want     string
+	}{
+		{filename: "invoice.pdf", content: []byte("%PDF-1.4\n"), want: "application/pdf"},
+		{filename: "invoice.PDF", content: []byte{0, 1, 2, 3}, want: "application/pdf"},
+		{filename: "report.xlsx", content: zipHeader, want: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+		{filename: "letter.docx", content: zipHeader, want: "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+		{filename: "archive.zip", content: zipHeader, want: "application/zip"},
+		{filename: "data.csv", content: []byte("a;b\n1;2\n"), want: "text/csv"},
+		{filename: "image.png", content: []byte("\x89PNG\r\n\x1a\n"), want: "image/png"},
+		{filename: "unknown", content: []byte{0, 1, 2, 3}, want: "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			a := NewAttachment("1", tt.filename, tt.content)
+			require.Equal(t, tt.want, a.ContentType)
+			require.Equal(t, tt.want, a.DetectContentType())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package email
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/domonda/go-types/language"
+	"github.com/domonda/go-types/strutil"
+)
+
+// stopwords are frequent words that are
+// characteristic for a language and not
+// commonly used in the other languages.
+var stopwords = map[language.Code]map[string]struct{}{
+	language.EN: makeWordSet(
+		"the", "and", "of", "to", "is", "are", "was", "were", "you", "your",
+		"for", "with", "this", "that", "have", "has", "not", "be", "it", "from",
+		"we", "our", "will", "would", "please", "thank", "thanks", "regards", "attached", "at",
+	),
+	language.DE: makeWordSet(
+		"der", "das", "und", "ist", "sind", "nicht", "mit", "sie", "ich", "wir",
+		"ein", "eine", "einen", "dem", "den", "zu", "auf", "für", "von", "bitte",
+		"danke", "grüße", "freundlichen", "ihre", "ihnen", "uns", "auch", "wird", "werden", "anbei",
+	),
+	language.FR: makeWordSet(
+		"le", "la", "les", "et", "est", "sont", "une", "du", "pour", "avec",
+		"dans", "nous", "vous", "votre", "pas", "qui", "que", "sur", "au", "aux",
+		"merci", "cordialement", "bonjour", "ci-joint", "veuillez", "ce", "cette", "être", "avons", "je",
+	),
+}
+
+// stopwordLanguages defines the order of the
+// languages to deterministically break ties.
+var stopwordLanguages = []language.Code{language.EN, language.DE, language.FR}
+
+func makeWordSet(words ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		set[word] = struct{}{}
+	}
+	return set
+}
+
+// DetectBodyLanguage returns the most likely language
+// of the message Body, or of the text of BodyHTML if Body is empty,
+// together with a confidence between 0 and 1.
+//
+// The detection counts the stopwords of the languages
+// EN, DE, and FR in the text and the confidence is the share
+// of stopwords belonging to the returned language.
+// An empty language code and zero confidence are returned
+// if the body is empty or contains no stopwords.
+func (msg *Message) DetectBodyLanguage() (lang language.Code, confidence float64) {
+	text := msg.Body
+	if strutil.TrimSpace(text) == "" && msg.BodyHTML.IsNotNull() {
+		text, _ = HTMLToPlaintext([]byte(msg.BodyHTML), " ")
+	}
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '-'
+	})
+	counts := make(map[language.Code]int, len(stopwordLanguages))
+	total := 0
+	for _, word := range words {
+		for _, l := range stopwordLanguages {
+			if _, ok := stopwords[l][word]; ok {
+				counts[l]++
+				total++
+			}
+		}
+	}
+	if total == 0 {
+		return "", 0
+	}
+	for _, l := range stopwordLanguages {
+		if counts[l] > counts[lang] {
+			lang = l
+		}
+	}
+	return lang, float64(counts[lang]) / float64(total)
+}
@@ -0,0 +1,51 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/domonda/go-types/language"
+	"github.com/domonda/go-types/nullable"
+)
+
+func TestMessage_DetectBodyLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      *Message
+		wantLang language.Code
+	}{
+		{
+			name:     "German",
+			msg:      &Message{Body: "Sehr geehrte Damen und Herren,\n\nanbei erhalten Sie die Rechnung für den Monat März. Bitte überweisen Sie den Betrag auf das angegebene Konto.\n\nMit freundlichen Grüßen"},
+			wantLang: language.DE,
+		},
+		{
+			name:     "English",
+			msg:      &Message{Body: "Dear Sir or Madam,\n\nplease find attached the invoice for the month of March. Please transfer the amount to the account stated on the invoice.\n\nKind regards"},
+			wantLang: language.EN,
+		},
+		{
+			name:     "French",
+			msg:      &Message{Body: "Bonjour,\n\nveuillez trouver ci-joint la facture pour le mois de mars. Merci de virer le montant sur le compte indiqué.\n\nCordialement"},
+			wantLang: language.FR,
+		},
+		{
+			name:     "German HTML",
+			msg:      &Message{BodyHTML: nullable.TrimmedStringFrom("<p>Anbei die Rechnung.</p><p>Mit freundlichen Grüßen</p>")},
+			wantLang: language.DE,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, confidence := tt.msg.DetectBodyLanguage()
+			require.Equal(t, tt.wantLang, lang)
+			require.Greater(t, confidence, 0.5)
+			require.LessOrEqual(t, confidence, 1.0)
+		})
+	}
+
+	lang, confidence := (&Message{}).DetectBodyLanguage()
+	require.Equal(t, language.Code(""), lang)
+	require.Zero(t, confidence)
+}
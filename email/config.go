@@ -1,5 +1,19 @@
 package email
 
+import (
+	"strings"
+
+	"github.com/domonda/go-types/strutil"
+)
+
+// MaxAttachmentBytes is the maximum size of an attachment
+// kept by ParseMessage and the other message parsing functions.
+// Larger attachments are skipped and recorded in Message.ParseWarnings.
+// Zero or a negative value means no limit.
+// Note that the attachments are still decoded in memory,
+// use ParseMessageFileLimit to limit the size of the whole message.
+var MaxAttachmentBytes int64
+
 // ProviderDomains returns a set of known email provider domain names.
 func ProviderDomains() map[string]struct{} {
 	return map[string]struct{}{
@@ -117,3 +131,62 @@ func ProviderDomains() map[string]struct{} {
 		"zonnet.nl":         {},
 	}
 }
+
+// RoleLocalParts is the set of lower case local parts
+// of email addresses used for generic role mailboxes
+// instead of individual persons, see Address.IsRoleAddress.
+// Add entries to extend the detection.
+var RoleLocalParts = map[string]struct{}{
+	"abuse":      {},
+	"admin":      {},
+	"billing":    {},
+	"info":       {},
+	"no-reply":   {},
+	"noreply":    {},
+	"office":     {},
+	"postmaster": {},
+	"sales":      {},
+	"support":    {},
+}
+
+// DisposableDomains is the set of lower case domain names
+// of well-known disposable or throwaway email providers,
+// see Address.IsDisposableDomain and SetDisposableDomains.
+var DisposableDomains = map[string]struct{}{
+	"10minutemail.com":  {},
+	"discard.email":     {},
+	"dispostable.com":   {},
+	"emailondeck.com":   {},
+	"fakeinbox.com":     {},
+	"getnada.com":       {},
+	"guerrillamail.com": {},
+	"guerrillamail.de":  {},
+	"guerrillamail.net": {},
+	"guerrillamail.org": {},
+	"maildrop.cc":       {},
+	"mailinator.com":    {},
+	"mailnesia.com":     {},
+	"mintemail.com":     {},
+	"mohmal.com":        {},
+	"sharklasers.com":   {},
+	"temp-mail.org":     {},
+	"tempmail.com":      {},
+	"throwawaymail.com": {},
+	"trashmail.com":     {},
+	"trashmail.de":      {},
+	"yopmail.com":       {},
+}
+
+// SetDisposableDomains replaces DisposableDomains
+// with the passed domain names.
+// The domains are trimmed and converted to lower case.
+func SetDisposableDomains(domains []string) {
+	set := make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		domain = strings.ToLower(strutil.TrimSpace(domain))
+		if domain != "" {
+			set[domain] = struct{}{}
+		}
+	}
+	DisposableDomains = set
+}
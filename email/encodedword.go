@@ -0,0 +1,47 @@
+package email
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+
+	"golang.org/x/net/html/charset"
+	"golang.org/x/text/encoding/ianaindex"
+)
+
+// encodedWordDecoder supports all charsets known
+// to golang.org/x/net/html/charset
+var encodedWordDecoder = mime.WordDecoder{CharsetReader: charset.NewReaderLabel}
+
+// DecodeEncodedWord decodes all RFC 2047 encoded-words
+// like "=?utf-8?q?J=C3=BCrgen?=" or "=?utf-8?b?SsO8cmdlbg==?="
+// in s using B (base64) or Q (quoted-printable) encoding.
+// Whitespace between adjacent encoded-words is removed
+// as required by RFC 2047, text that is not encoded
+// is returned unchanged.
+func DecodeEncodedWord(s string) (string, error) {
+	decoded, err := encodedWordDecoder.DecodeHeader(s)
+	if err != nil {
+		return "", fmt.Errorf("can't decode RFC 2047 encoded-word %q: %w", s, err)
+	}
+	return decoded, nil
+}
+
+// EncodeWord returns s as RFC 2047 Q encoded-word
+// using the passed charset like "utf-8" or "iso-8859-1".
+// Strings that don't need encoding are returned unchanged.
+// utf-8 is used if charset is empty or unknown
+// or if s can't be represented in charset.
+// Long strings are split into multiple encoded-words
+// separated by a space.
+func EncodeWord(charsetName, s string) string {
+	charsetName = strings.ToLower(charsetName)
+	if charsetName != "" && charsetName != "utf-8" {
+		if enc, err := ianaindex.MIME.Encoding(charsetName); err == nil && enc != nil {
+			if encoded, err := enc.NewEncoder().String(s); err == nil {
+				return mime.QEncoding.Encode(charsetName, encoded)
+			}
+		}
+	}
+	return mime.QEncoding.Encode("utf-8", s)
+}
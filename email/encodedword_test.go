@@ -0,0 +1,48 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeEncodedWord(t *testing.T) {
+	tests := map[string]string{
+		``:                                    ``,
+		`Plain text`:                          `Plain text`,
+		`=?utf-8?b?wqFIb2xhLCBzZcOxb3Ih?=`:    `¡Hola, señor!`,
+		`=?UTF-8?B?wqFIb2xhLCBzZcOxb3Ih?=`:    `¡Hola, señor!`,
+		`=?utf-8?q?J=C3=BCrgen_M=C3=BCller?=`: `Jürgen Müller`,
+		`=?iso-8859-1?q?Gr=FC=DFe_aus_=D6sterreich?=`:          `Grüße aus Österreich`,
+		`=?windows-1252?q?Stra=DFe?=`:                          `Straße`,
+		`=?utf-8?q?J=C3=BCrgen?= =?utf-8?q?_M=C3=BCller?=`:     `Jürgen Müller`,
+		"=?utf-8?q?J=C3=BCrgen?=\r\n =?utf-8?b?IE3DvGxsZXI=?=": `Jürgen Müller`,
+		`Re: =?utf-8?q?=C3=84nderung?= der Rechnung`:           `Re: Änderung der Rechnung`,
+		`=?utf-8?q?a?= b =?utf-8?q?c?=`:                        `a b c`,
+	}
+	for encoded, want := range tests {
+		got, err := DecodeEncodedWord(encoded)
+		require.NoError(t, err, "DecodeEncodedWord(%q)", encoded)
+		require.Equal(t, want, got, "DecodeEncodedWord(%q)", encoded)
+	}
+
+	_, err := DecodeEncodedWord(`=?unknown-charset?q?abc?=`)
+	require.Error(t, err)
+}
+
+func TestEncodeWord(t *testing.T) {
+	require.Equal(t, `Plain text`, EncodeWord("utf-8", `Plain text`))
+	require.Equal(t, `=?utf-8?q?J=C3=BCrgen_M=C3=BCller?=`, EncodeWord("utf-8", `Jürgen Müller`))
+	require.Equal(t, `=?utf-8?q?J=C3=BCrgen_M=C3=BCller?=`, EncodeWord("", `Jürgen Müller`))
+	require.Equal(t, `=?utf-8?q?J=C3=BCrgen_M=C3=BCller?=`, EncodeWord("unknown", `Jürgen Müller`))
+	require.Equal(t, `=?iso-8859-1?q?J=FCrgen_M=FCller?=`, EncodeWord("ISO-8859-1", `Jürgen Müller`))
+	require.Equal(t, `=?utf-8?q?=E2=82=AC?=`, EncodeWord("iso-8859-1", `€`), "not representable in charset")
+
+	for _, s := range []string{`¡Hola, señor!`, `Grüße aus Österreich`, `Straße`, `€ 100`} {
+		for _, cs := range []string{"utf-8", "iso-8859-1", "iso-8859-15", "windows-1252"} {
+			decoded, err := DecodeEncodedWord(EncodeWord(cs, s))
+			require.NoError(t, err)
+			require.Equal(t, s, decoded, "round trip %q with charset %s", s, cs)
+		}
+	}
+}
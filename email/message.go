@@ -3,11 +3,17 @@ package email
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
+	"io"
+	"math"
 	"net/mail"
 	"net/textproto"
+	"path"
+	"strconv"
 	"strings"
 	txttemplate "text/template"
 	"time"
@@ -91,6 +97,10 @@ type Message struct {
 	BodyHTML nullable.TrimmedString `json:"bodyHTML,omitempty"`
 
 	Attachments []*Attachment `json:"attachments,omitempty"`
+
+	// ParseWarnings are non fatal problems found while parsing
+	// the message, like attachments skipped because of MaxAttachmentBytes.
+	ParseWarnings []string `json:"parseWarnings,omitempty"`
 }
 
 // NewMessage returns a new message using the passed from, to, subject, body, and bodyHTML arguments.
@@ -105,6 +115,68 @@ func NewMessage(from Address, to AddressList, subject, body string, bodyHTML nul
 	}
 }
 
+// Validate returns an error if From, ReplyTo, DeliveredTo,
+// or any address of To, Cc, or Bcc is invalid,
+// if there is no recipient in To, Cc, or Bcc,
+// if Date is set but zero, if any attachment is nil,
+// or if the message has neither Body, BodyHTML, nor Attachments.
+// A nil Date is valid because it is usually
+// set by the mail server when sending the message.
+// All errors are joined with errors.Join
+// and prefixed with the name of the field.
+func (msg *Message) Validate() error {
+	if msg == nil {
+		return errors.New("nil email.Message")
+	}
+	var err error
+	if e := msg.From.Validate(); e != nil {
+		err = errors.Join(err, fmt.Errorf("From: %w", e))
+	}
+	if e := msg.ReplyTo.Validate(); e != nil {
+		err = errors.Join(err, fmt.Errorf("ReplyTo: %w", e))
+	}
+	if e := msg.DeliveredTo.Validate(); e != nil {
+		err = errors.Join(err, fmt.Errorf("DeliveredTo: %w", e))
+	}
+	if msg.To != "" {
+		if e := msg.To.Validate(); e != nil {
+			err = errors.Join(err, fmt.Errorf("To: %w", e))
+		}
+	}
+	if e := msg.Cc.Validate(); e != nil {
+		err = errors.Join(err, fmt.Errorf("Cc: %w", e))
+	}
+	if e := msg.Bcc.Validate(); e != nil {
+		err = errors.Join(err, fmt.Errorf("Bcc: %w", e))
+	}
+	if msg.To == "" && msg.Cc.IsNull() && msg.Bcc.IsNull() {
+		err = errors.Join(err, errors.New("no recipient in To, Cc, or Bcc"))
+	}
+	if msg.Date != nil && msg.Date.IsZero() {
+		err = errors.Join(err, errors.New("Date: zero time"))
+	}
+	for i, att := range msg.Attachments {
+		if att == nil {
+			err = errors.Join(err, fmt.Errorf("Attachments[%d]: nil attachment", i))
+		}
+	}
+	if msg.Body == "" && msg.BodyHTML.IsNull() && len(msg.Attachments) == 0 {
+		err = errors.Join(err, errors.New("no Body, BodyHTML, or Attachments"))
+	}
+	return err
+}
+
+// EnsurePlainText sets Body to a plaintext version
+// of BodyHTML if Body is empty and BodyHTML is not,
+// so that text only consumers get a readable body.
+// Line breaks are derived from br elements
+// and block elements like p and li.
+func (msg *Message) EnsurePlainText() {
+	if msg.Body == "" && msg.BodyHTML.IsNotNull() {
+		msg.Body = htmlToPlaintext(string(msg.BodyHTML))
+	}
+}
+
 // Recipients returns the valid, normalized, name stripped,
 // deduplicated addresses from the To, Cc, and Bcc fields.
 func (msg *Message) Recipients() []string {
@@ -139,19 +211,80 @@ func (msg *Message) Recipients() []string {
 }
 
 // ReferencesMessageIDs returns the message IDs listed in the References header.
+// The IDs can be separated by whitespace as specified by RFC 5322
+// or by commas.
 func (msg *Message) ReferencesMessageIDs() []string {
 	if msg.References.IsNull() {
 		return nil
 	}
 	var ids []string
-	for _, id := range strings.Split(string(msg.References), ",") {
-		if id = strutil.TrimSpace(id); id != "" {
-			ids = append(ids, id)
-		}
+	for _, part := range strutil.SplitAndTrim(string(msg.References), ",") {
+		ids = append(ids, strings.FieldsFunc(part, strutil.IsSpace)...)
 	}
 	return ids
 }
 
+// ThreadRootID returns the message ID of the first message
+// of the thread this message belongs to.
+// That is the first ID of the References header,
+// or the In-Reply-To header if there are no References,
+// or the message's own MessageID as fallback.
+func (msg *Message) ThreadRootID() string {
+	if refs := msg.ReferencesMessageIDs(); len(refs) > 0 {
+		return refs[0]
+	}
+	if msg.InReplyTo.IsNotNull() {
+		return msg.InReplyTo.Get()
+	}
+	return msg.MessageID.StringOr("")
+}
+
+// IsReply returns true if the In-Reply-To header is set
+// or the subject starts with a reply prefix like "Re:" or "AW:".
+func (msg *Message) IsReply() bool {
+	if msg.InReplyTo.IsNotNull() {
+		return true
+	}
+	prefix, _, found := strings.Cut(msg.Subject, ":")
+	return found && replySubjectPrefixes[strings.ToLower(strutil.TrimSpace(prefix))]
+}
+
+// replySubjectPrefixes are the lower case subject
+// prefixes without colon used for replies.
+var replySubjectPrefixes = map[string]bool{
+	"re":   true,
+	"aw":   true, // German "Antwort"
+	"antw": true, // German and Dutch
+	"rif":  true, // Italian "Riferimento"
+	"réf":  true, // French "Référence"
+}
+
+// forwardSubjectPrefixes are the lower case subject
+// prefixes without colon used for forwarded messages.
+var forwardSubjectPrefixes = map[string]bool{
+	"fwd": true,
+	"fw":  true,
+	"wg":  true, // German "Weitergeleitet"
+}
+
+// StripReplyPrefix removes all leading reply and forward
+// prefixes like "Re:", "AW:", "Fwd:", or "WG:"
+// case-insensitively from a subject.
+func StripReplyPrefix(subject string) string {
+	subject = strutil.TrimSpace(subject)
+	for {
+		prefix, rest, found := strings.Cut(subject, ":")
+		if !found {
+			return subject
+		}
+		prefix = strings.ToLower(strutil.TrimSpace(prefix))
+		if !replySubjectPrefixes[prefix] && !forwardSubjectPrefixes[prefix] {
+			return subject
+		}
+		subject = strutil.TrimSpace(rest)
+	}
+}
+
 func ParseMessageFile(ctx context.Context, file fs.FileReader) (msg *Message, err error) {
 	defer errs.WrapWithFuncParams(&err, ctx, file)
 
@@ -163,6 +296,43 @@ func ParseMessageFile(ctx context.Context, file fs.FileReader) (msg *Message, er
 	return ParseMessage(data)
 }
 
+// ParseMessageFileLimit parses file like ParseMessageFile
+// but returns an error without reading the whole file
+// if it is larger than maxBytes.
+// The file is streamed and reading stops after maxBytes
+// in case the size of the file is not known in advance.
+// A maxBytes of zero or less means no limit.
+func ParseMessageFileLimit(ctx context.Context, file fs.FileReader, maxBytes int64) (msg *Message, err error) {
+	defer errs.WrapWithFuncParams(&err, ctx, file, maxBytes)
+
+	if maxBytes <= 0 {
+		return ParseMessageFile(ctx, file)
+	}
+	if size := file.Size(); size > maxBytes {
+		return nil, fmt.Errorf("email message file %q has %d bytes exceeding the limit of %d bytes", file.Name(), size, maxBytes)
+	}
+	reader, err := file.OpenReader()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	// Read one byte more than the limit to detect files exceeding it
+	data, err := fs.ReadAllContext(ctx, io.LimitReader(reader, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("email message file %q exceeds the limit of %d bytes", file.Name(), maxBytes)
+	}
+
+	return ParseMessage(data)
+}
+
+// ParseMessage parses data as JSON encoded Message,
+// TNEF message, or MIME message in that order.
+// Before MIME parsing, data consisting only of base64
+// is decoded and a leading mbox "From " line is removed.
 func ParseMessage(data []byte) (msg *Message, err error) {
 	defer errs.WrapWithFuncParams(&err, data)
 
@@ -176,6 +346,7 @@ func ParseMessage(data []byte) (msg *Message, err error) {
 		if err != nil {
 			return nil, err
 		}
+		msg.removeOversizedAttachments()
 		return msg, nil
 	}
 
@@ -185,9 +356,86 @@ func ParseMessage(data []byte) (msg *Message, err error) {
 		return tnefMessage, nil
 	}
 
+	if decoded, ok := decodeBase64Message(data); ok {
+		data = decoded
+	}
+	data = stripMboxFromLine(data)
+
 	return ParseMIMEMessageBytes(data)
 }
 
+// stripMboxFromLine removes a leading mbox "From " separator line.
+func stripMboxFromLine(data []byte) []byte {
+	if !bytes.HasPrefix(data, []byte("From ")) {
+		return data
+	}
+	i := bytes.IndexByte(data, '\n')
+	if i == -1 {
+		return data
+	}
+	return data[i+1:]
+}
+
+// removeOversizedAttachments removes attachments larger
+// than MaxAttachmentBytes and records them in ParseWarnings.
+func (msg *Message) removeOversizedAttachments() {
+	if MaxAttachmentBytes <= 0 {
+		return
+	}
+	attachments := msg.Attachments[:0]
+	for _, a := range msg.Attachments {
+		if size := int64(len(a.FileData)); size > MaxAttachmentBytes {
+			msg.ParseWarnings = append(msg.ParseWarnings, fmt.Sprintf("skipped attachment %q with %d bytes exceeding MaxAttachmentBytes of %d", a.FileName, size, MaxAttachmentBytes))
+			continue
+		}
+		attachments = append(attachments, a)
+	}
+	msg.Attachments = attachments
+}
+
+// decodeBase64Message decodes data if it consists only
+// of standard base64 characters and line breaks
+// and the decoded result starts with a MIME header field.
+// A MIME message can't be mistaken for base64
+// because header lines contain ':' and spaces,
+// and checking the decoded header prevents short plain text
+// made only of base64 characters from being decoded.
+func decodeBase64Message(data []byte) (decoded []byte, ok bool) {
+	encoded := make([]byte, 0, len(data))
+	for _, c := range data {
+		switch {
+		case c == '\r' || c == '\n':
+			continue
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '+', c == '/', c == '=':
+			encoded = append(encoded, c)
+		default:
+			return nil, false
+		}
+	}
+	decoded = make([]byte, base64.StdEncoding.DecodedLen(len(encoded)))
+	n, err := base64.StdEncoding.Decode(decoded, encoded)
+	if err != nil || !startsWithHeaderField(stripMboxFromLine(decoded[:n])) {
+		return nil, false
+	}
+	return decoded[:n], true
+}
+
+// startsWithHeaderField returns if the first line of data
+// is a header field with a non empty name of printable
+// US-ASCII characters followed by a colon, see RFC 5322.
+func startsWithHeaderField(data []byte) bool {
+	name, _, found := bytes.Cut(data, []byte{':'})
+	if !found || len(name) == 0 {
+		return false
+	}
+	for _, c := range name {
+		if c < 33 || c > 126 {
+			return false
+		}
+	}
+	return true
+}
+
 // ReplyToAddress returns the ReplyTo address if available,
 // else the From address.
 func (msg *Message) ReplyToAddress() Address {
@@ -238,6 +486,98 @@ func (msg *Message) FeedbackID() string {
 	return msg.ExtraHeader.Get("Feedback-Id")
 }
 
+// SpamScore returns the spam score from the "X-Spam-Score" header
+// or from the "score=" value of the "X-Spam-Status" header.
+// Trailing annotations after the number like "5.2 (+++++)"
+// and decimal commas are tolerated.
+// Returns false if no parseable score is available.
+func (msg *Message) SpamScore() (score float64, ok bool) {
+	if value := msg.ExtraHeader.Get("X-Spam-Score"); value != "" {
+		return parseSpamScore(value)
+	}
+	status := msg.ExtraHeader.Get("X-Spam-Status")
+	if _, after, found := strings.Cut(status, "score="); found {
+		return parseSpamScore(after)
+	}
+	return 0, false
+}
+
+func parseSpamScore(value string) (float64, bool) {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '(' || r == ';' || r == '/'
+	})
+	if len(fields) == 0 {
+		return 0, false
+	}
+	score, err := strconv.ParseFloat(strings.Replace(fields[0], ",", ".", 1), 64)
+	if err != nil || math.IsNaN(score) || math.IsInf(score, 0) {
+		return 0, false
+	}
+	return score, true
+}
+
+// IsSpamFlagged returns if the "X-Spam-Flag" header is "YES"
+// or the "X-Spam-Status" header starts with "Yes"
+// as set by spam filters like SpamAssassin.
+func (msg *Message) IsSpamFlagged() bool {
+	if strings.EqualFold(strings.TrimSpace(msg.ExtraHeader.Get("X-Spam-Flag")), "YES") {
+		return true
+	}
+	status := strings.ToLower(strings.TrimSpace(msg.ExtraHeader.Get("X-Spam-Status")))
+	return strings.HasPrefix(status, "yes")
+}
+
+// AuthResults returns the lower case SPF, DKIM, and DMARC results
+// like "pass", "fail", "softfail", or "none" from the
+// "Authentication-Results" headers defined in RFC 8601.
+// If there are multiple headers or results for a method,
+// then the first one is returned.
+// An empty string is returned for methods without result.
+func (msg *Message) AuthResults() (spf, dkim, dmarc string) {
+	results := map[string]*string{"spf": &spf, "dkim": &dkim, "dmarc": &dmarc}
+	for _, header := range msg.ExtraHeader.Values("Authentication-Results") {
+		// The first element is the authserv-id like "mx.google.com"
+		for _, resinfo := range strings.Split(stripHeaderComments(header), ";")[1:] {
+			fields := strings.Fields(resinfo)
+			if len(fields) == 0 {
+				continue
+			}
+			method, result, ok := strings.Cut(strings.ToLower(fields[0]), "=")
+			if p := results[method]; ok && p != nil && *p == "" {
+				*p = result
+			}
+		}
+	}
+	return spf, dkim, dmarc
+}
+
+// stripHeaderComments removes RFC 5322 comments
+// in parentheses, which can be nested, from a header value.
+func stripHeaderComments(value string) string {
+	var (
+		b     strings.Builder
+		depth int
+	)
+	for _, r := range value {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Precedence returns the lower case value of the "Precedence" header
+// like "bulk", "list", or "junk"
+// or an empty string if not available.
+func (msg *Message) Precedence() string {
+	return strings.ToLower(strings.TrimSpace(msg.ExtraHeader.Get("Precedence")))
+}
+
 func (msg *Message) String() string {
 	return fmt.Sprintf(
 		"Message{Subject: `%s`, From: %s, DeliveredTo: %s, MessageID: %s, ProviderID: %s, Labels: %s}",
@@ -263,6 +603,63 @@ func (msg *Message) AddAttachmentReadFile(ctx context.Context, partID string, fi
 	return nil
 }
 
+// InlineAttachments returns the attachments marked as Inline,
+// like images referenced from the HTML body by their ContentID
+// with a "cid:" URL.
+// The returned attachments are also part of msg.Attachments.
+func (msg *Message) InlineAttachments() []*Attachment {
+	var inlines []*Attachment
+	for _, att := range msg.Attachments {
+		if att.Inline {
+			inlines = append(inlines, att)
+		}
+	}
+	return inlines
+}
+
+// SaveAttachments writes all attachments as files into dir
+// and returns the written files in the order of msg.Attachments.
+// The attachment filenames are sanitized with strutil.SanitizeFileName
+// and made unique by appending " (1)", " (2)", etc. before
+// the extension if a file with the same name already exists.
+func (msg *Message) SaveAttachments(ctx context.Context, dir fs.File) (files []fs.File, err error) {
+	defer errs.WrapWithFuncParams(&err, ctx, dir)
+
+	if !dir.IsDir() {
+		return nil, fs.NewErrIsNotDirectory(dir)
+	}
+	used := make(map[string]struct{}, len(msg.Attachments))
+	for _, attachment := range msg.Attachments {
+		if ctx.Err() != nil {
+			return files, ctx.Err()
+		}
+		file := uniqueAttachmentFile(dir, attachment.FileName, used)
+		err = file.WriteAllContext(ctx, attachment.FileData)
+		if err != nil {
+			return files, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func uniqueAttachmentFile(dir fs.File, filename string, used map[string]struct{}) fs.File {
+	filename = strutil.SanitizeFileName(filename)
+	if filename == "" {
+		filename = "attachment"
+	}
+	ext := path.Ext(filename)
+	base := strings.TrimSuffix(filename, ext)
+	for i := 1; ; i++ {
+		_, isUsed := used[filename]
+		if !isUsed && !dir.Join(filename).Exists() {
+			used[filename] = struct{}{}
+			return dir.Join(filename)
+		}
+		filename = fmt.Sprintf("%s (%d)%s", base, i, ext)
+	}
+}
+
 type ReplyTemplateData struct {
 	Message
 	Date      string
@@ -298,47 +695,86 @@ func (msg *Message) NewReplyMessage(from Address, replyText, replyHTML string, k
 		re.Attachments = msg.Attachments
 	}
 
+	err = msg.executeReplyTemplates(re, replyText, replyHTML, textTempl, htmlTempl)
+	if err != nil {
+		return nil, err
+	}
+	return re, nil
+}
+
+// NewForwardMessage creates a message forwarding an existing message
+// to the passed to addresses.
+// The textTempl and htmlTempl templates will called with
+// a ReplyTemplateData struct instance as context
+// where ReplyText and ReplyHTML are set to the passed introText and introHTML.
+// The templates are responsible to render the intro together with
+// the original From, Date, To, Subject headers and body of the message.
+// The passed introText and introHTML are not interpreted as templates.
+func (msg *Message) NewForwardMessage(from Address, to AddressList, introText, introHTML string, keepAttachments bool, textTempl, htmlTempl string) (fwd *Message, err error) {
+	defer errs.WrapWithFuncParams(&err, from, to, introText, introHTML, keepAttachments, textTempl, htmlTempl)
+
+	fwd = &Message{
+		References:  msg.MessageID,
+		From:        from,
+		To:          to,
+		Subject:     "Fwd: " + msg.Subject,
+		ExtraHeader: make(Header),
+	}
+	if keepAttachments {
+		fwd.Attachments = msg.Attachments
+	}
+
+	err = msg.executeReplyTemplates(fwd, introText, introHTML, textTempl, htmlTempl)
+	if err != nil {
+		return nil, err
+	}
+	return fwd, nil
+}
+
+// executeReplyTemplates sets the Body and BodyHTML of dest
+// by executing textTempl and htmlTempl with a ReplyTemplateData
+// quoting msg and containing the passed text and html.
+func (msg *Message) executeReplyTemplates(dest *Message, text, html, textTempl, htmlTempl string) error {
 	//#nosec G203 -- not escaped HTML OK
 	data := &ReplyTemplateData{
 		Message:   *msg,
 		Date:      formatDate(msg.Date),
 		BodyLines: strings.Split(msg.Body, "\n"),
 		BodyHTML:  template.HTML(msg.BodyHTML),
-		ReplyText: replyText,
-		ReplyHTML: template.HTML(replyHTML),
+		ReplyText: text,
+		ReplyHTML: template.HTML(html),
 	}
 	if data.BodyHTML == "" {
 		data.BodyHTML = plaintextToHTML(msg.Body)
 	}
 	if data.ReplyHTML == "" {
-		data.ReplyHTML = plaintextToHTML(replyText)
+		data.ReplyHTML = plaintextToHTML(text)
 	}
 
 	var b strings.Builder
 	textTemplate, err := txttemplate.New("text").Parse(textTempl)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	err = textTemplate.Execute(&b, data)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	re.Body = b.String()
+	dest.Body = b.String()
 
-	if msg.BodyHTML.IsNotNull() || replyHTML != "" {
+	if msg.BodyHTML.IsNotNull() || html != "" {
 		var b strings.Builder
 		htmlTemplate, err := template.New("html").Parse(htmlTempl)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		err = htmlTemplate.Execute(&b, data)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		re.BodyHTML.Set(b.String())
+		dest.BodyHTML.Set(b.String())
 	}
-
-	return re, nil
+	return nil
 }
 
 func (msg *Message) BuildRawMessage() (raw []byte, err error) {
@@ -381,25 +817,32 @@ func (msg *Message) BuildRawMessage() (raw []byte, err error) {
 		root = enmime.NewPart("multipart/alternative")
 		root.AddChild(part)
 	}
-	// if len(b.inlines) > 0 {
-	// 	part = root
-	// 	root = enmime.NewPart("multipart/related")
-	// 	root.AddChild(part)
-	// 	for _, ip := range b.inlines {
-	// part := enmime.NewPart(contentType)
-	// part.Content = content
-	// part.FileName = fileName
-	// part.Disposition = "inline"
-	// part.ContentID = contentID
-	// 		part.Header = make(textproto.MIMEHeader)
-	// 		root.AddChild(part)
-	// 	}
-	// }
-	if len(msg.Attachments) > 0 {
+	var inlines, attachments []*Attachment
+	for _, att := range msg.Attachments {
+		if att.Inline {
+			inlines = append(inlines, att)
+		} else {
+			attachments = append(attachments, att)
+		}
+	}
+	if len(inlines) > 0 {
+		part = root
+		root = enmime.NewPart("multipart/related")
+		root.AddChild(part)
+		for _, att := range inlines {
+			part := enmime.NewPart(att.ContentType)
+			part.Content = att.FileData
+			part.FileName = att.FileName
+			part.Disposition = "inline"
+			part.ContentID = att.ContentID
+			root.AddChild(part)
+		}
+	}
+	if len(attachments) > 0 {
 		part = root
 		root = enmime.NewPart("multipart/mixed")
 		root.AddChild(part)
-		for _, att := range msg.Attachments {
+		for _, att := range attachments {
 			part := enmime.NewPart(att.ContentType)
 			part.Content = att.FileData
 			part.FileName = att.FileName
@@ -415,8 +858,10 @@ func (msg *Message) BuildRawMessage() (raw []byte, err error) {
 	if msg.InReplyTo.IsNotNull() {
 		root.Header.Set("In-Reply-To", msg.InReplyTo.Get())
 	}
-	if msg.References.IsNotNull() {
-		root.Header.Set("References", msg.References.Get())
+	if refs := msg.ReferencesMessageIDs(); len(refs) > 0 {
+		// Separate IDs by spaces as specified by RFC 5322
+		// so that the encoder can fold long headers between them
+		root.Header.Set("References", strings.Join(refs, " "))
 	}
 	root.Header.Set("From", string(msg.From))
 	if msg.ReplyTo.IsNotNull() {
@@ -458,3 +903,58 @@ func (msg *Message) BuildRawMessage() (raw []byte, err error) {
 	}
 	return buf.Bytes(), nil
 }
+
+// Size returns the size in bytes of the encoded message
+// as returned by BuildRawMessage, which is the size
+// to check against message size limits of email providers.
+// Note that base64 encoding inflates attachment data by about 33%
+// compared to the raw data size returned by AttachmentsTotalSize.
+func (msg *Message) Size() (int, error) {
+	raw, err := msg.BuildRawMessage()
+	if err != nil {
+		return 0, err
+	}
+	return len(raw), nil
+}
+
+// AttachmentsTotalSize returns the sum of the raw
+// not encoded data sizes of all attachments
+// including inline attachments.
+// See Size for the encoded size of the whole message.
+func (msg *Message) AttachmentsTotalSize() int {
+	total := 0
+	for _, att := range msg.Attachments {
+		total += len(att.FileData)
+	}
+	return total
+}
+
+// BuildRawMessageCRLF returns the result of BuildRawMessage
+// with every line terminated by CRLF as required by RFC 5322
+// and strict IMAP APPEND implementations.
+// Bare LF line endings are converted to CRLF,
+// existing CRLF line endings are kept unchanged.
+func (msg *Message) BuildRawMessageCRLF() (raw []byte, err error) {
+	raw, err = msg.BuildRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return normalizeCRLF(raw), nil
+}
+
+// normalizeCRLF converts all LF line endings
+// that are not preceded by CR to CRLF.
+func normalizeCRLF(data []byte) []byte {
+	numBareLF := bytes.Count(data, []byte("\n")) - bytes.Count(data, []byte("\r\n"))
+	if numBareLF == 0 {
+		return data
+	}
+	result := make([]byte, 0, len(data)+numBareLF)
+	for i, b := range data {
+		if b == '\n' && (i == 0 || data[i-1] != '\r') {
+			result = append(result, '\r')
+		}
+		result = append(result, b)
+	}
+	return result
+}
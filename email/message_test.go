@@ -0,0 +1,481 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ungerik/go-fs"
+
+	"github.com/domonda/go-types/nullable"
+)
+
+func TestMessage_NewForwardMessage(t *testing.T) {
+	date := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	msg := &Message{
+		MessageID: "<original@example.com>",
+		Date:      &date,
+		From:      "sender@example.com",
+		To:        "receiver@example.com",
+		Subject:   "Invoice",
+		Body:      "Please find the invoice attached.",
+	}
+	msg.AddAttachment("1", "invoice.pdf", []byte("%PDF-1.4"))
+
+	const textTempl = "{{.ReplyText}}\n\nFrom: {{.From}}\nDate: {{.Date}}\nTo: {{.To}}\nSubject: {{.Subject}}\n\n{{range .BodyLines}}> {{.}}\n{{end}}"
+
+	fwd, err := msg.NewForwardMessage("me@example.com", "accounting@example.com", "FYI", "", true, textTempl, "")
+	require.NoError(t, err)
+	require.Equal(t, "Fwd: Invoice", fwd.Subject)
+	require.Equal(t, Address("me@example.com"), fwd.From)
+	require.Equal(t, AddressList("accounting@example.com"), fwd.To)
+	require.Equal(t, msg.MessageID, fwd.References)
+	require.Equal(t, msg.Attachments, fwd.Attachments)
+	require.True(t, fwd.BodyHTML.IsNull(), "no HTML body without HTML source")
+	expectedBody := "FYI\n\n" +
+		"From: sender@example.com\n" +
+		"Date: Fri, 01 Mar 2024 12:00:00 +0000\n" +
+		"To: receiver@example.com\n" +
+		"Subject: Invoice\n\n" +
+		"> Please find the invoice attached.\n"
+	require.Equal(t, expectedBody, fwd.Body)
+
+	fwd, err = msg.NewForwardMessage("me@example.com", "accounting@example.com", "FYI", "", false, textTempl, "")
+	require.NoError(t, err)
+	require.Empty(t, fwd.Attachments)
+}
+
+const testMIMEMessage = "From: Sender <sender@example.com>\r\n" +
+	"To: receiver@example.com\r\n" +
+	"Subject: Test\r\n" +
+	"Date: Fri, 01 Mar 2024 12:00:00 +0000\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"\r\n" +
+	"Hello World\r\n"
+
+func TestParseMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "MIME", data: testMIMEMessage},
+		{name: "mbox From line", data: "From sender@example.com Fri Mar  1 12:00:00 2024\n" + testMIMEMessage},
+		{name: "base64", data: base64.StdEncoding.EncodeToString([]byte(testMIMEMessage))},
+		{name: "base64 with line breaks", data: wrapLines(base64.StdEncoding.EncodeToString([]byte(testMIMEMessage)), 76)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := ParseMessage([]byte(tt.data))
+			require.NoError(t, err)
+			require.Equal(t, "Test", msg.Subject)
+			require.Equal(t, Address(`"Sender" <sender@example.com>`), msg.From)
+			require.Equal(t, AddressList("receiver@example.com"), msg.To)
+			require.Equal(t, "Hello World\r\n", msg.Body)
+		})
+	}
+}
+
+func TestDecodeBase64Message(t *testing.T) {
+	decoded, ok := decodeBase64Message([]byte(base64.StdEncoding.EncodeToString([]byte(testMIMEMessage))))
+	require.True(t, ok)
+	require.Equal(t, testMIMEMessage, string(decoded))
+
+	// Plain text bodies made only of base64 characters
+	for _, data := range []string{"", "Test", "abcd", "Hallo", "SGVsbG8=", base64.StdEncoding.EncodeToString([]byte("Hello World: again"))} {
+		_, ok := decodeBase64Message([]byte(data))
+		require.False(t, ok, "decodeBase64Message(%q)", data)
+	}
+}
+
+func wrapLines(s string, width int) string {
+	var b strings.Builder
+	for len(s) > width {
+		b.WriteString(s[:width])
+		b.WriteString("\r\n")
+		s = s[width:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
+
+func TestMessage_SpamHeaders(t *testing.T) {
+	msg := &Message{ExtraHeader: make(Header)}
+	_, ok := msg.SpamScore()
+	require.False(t, ok, "no spam headers")
+	require.False(t, msg.IsSpamFlagged())
+	require.Equal(t, "", msg.Precedence())
+
+	msg.ExtraHeader.Set("X-Spam-Score", "5.2 (+++++)")
+	msg.ExtraHeader.Set("Precedence", " Bulk")
+	score, ok := msg.SpamScore()
+	require.True(t, ok)
+	require.Equal(t, 5.2, score)
+	require.False(t, msg.IsSpamFlagged())
+	require.Equal(t, "bulk", msg.Precedence())
+
+	msg.ExtraHeader.Set("X-Spam-Score", "-0,7")
+	score, ok = msg.SpamScore()
+	require.True(t, ok)
+	require.Equal(t, -0.7, score)
+
+	msg.ExtraHeader.Set("X-Spam-Score", "*****")
+	_, ok = msg.SpamScore()
+	require.False(t, ok, "unparseable score")
+
+	msg = &Message{ExtraHeader: make(Header)}
+	msg.ExtraHeader.Set("X-Spam-Flag", "YES")
+	msg.ExtraHeader.Set("X-Spam-Status", "Yes, score=12.3 required=5.0 tests=BAYES_99")
+	require.True(t, msg.IsSpamFlagged())
+	score, ok = msg.SpamScore()
+	require.True(t, ok, "score from X-Spam-Status")
+	require.Equal(t, 12.3, score)
+
+	msg.ExtraHeader.Set("X-Spam-Flag", "NO")
+	msg.ExtraHeader.Set("X-Spam-Status", "No, score=0.1 required=5.0")
+	require.False(t, msg.IsSpamFlagged())
+}
+
+func TestMessage_AuthResults(t *testing.T) {
+	msg := &Message{ExtraHeader: make(Header)}
+	spf, dkim, dmarc := msg.AuthResults()
+	require.Equal(t, [3]string{"", "", ""}, [3]string{spf, dkim, dmarc}, "no header")
+
+	msg.ExtraHeader.Set("Authentication-Results", "mx.google.com;\r\n"+
+		"       dkim=pass header.i=@example.com header.s=20230601 header.b=Abc123;\r\n"+
+		"       spf=pass (google.com: domain of sender@example.com designates 209.85.220.41 as permitted sender) smtp.mailfrom=sender@example.com;\r\n"+
+		"       dmarc=pass (p=NONE sp=NONE dis=NONE) header.from=example.com")
+	spf, dkim, dmarc = msg.AuthResults()
+	require.Equal(t, "pass", spf)
+	require.Equal(t, "pass", dkim)
+	require.Equal(t, "pass", dmarc)
+
+	msg = &Message{ExtraHeader: make(Header)}
+	msg.ExtraHeader.Add("Authentication-Results", "mail.example.org; spf=SoftFail smtp.mailfrom=example.net; dkim=none (no signature)")
+	msg.ExtraHeader.Add("Authentication-Results", "other.example.org; spf=pass smtp.mailfrom=example.net; dmarc=fail header.from=example.net")
+	spf, dkim, dmarc = msg.AuthResults()
+	require.Equal(t, "softfail", spf, "first result wins")
+	require.Equal(t, "none", dkim)
+	require.Equal(t, "fail", dmarc, "result from second header")
+}
+
+func TestMessage_SaveAttachments(t *testing.T) {
+	dir := fs.MustMakeTempDir()
+	defer dir.RemoveRecursive()
+
+	msg := &Message{}
+	msg.AddAttachment("1", "invoice.pdf", []byte("first"))
+	msg.AddAttachment("2", "invoice.pdf", []byte("second"))
+	msg.AddAttachment("3", "../secret.txt", []byte("third"))
+
+	files, err := msg.SaveAttachments(context.Background(), dir)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, "invoice.pdf", files[0].Name())
+	require.Equal(t, "invoice (1).pdf", files[1].Name())
+	require.Equal(t, dir, files[2].Dir(), "sanitized name written into dir")
+	for i, file := range files {
+		data, err := file.ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, msg.Attachments[i].FileData, data)
+	}
+
+	// Existing files are not overwritten
+	files, err = msg.SaveAttachments(context.Background(), dir)
+	require.NoError(t, err)
+	require.Equal(t, "invoice (2).pdf", files[0].Name())
+	require.Equal(t, "invoice (3).pdf", files[1].Name())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = msg.SaveAttachments(ctx, dir)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestMessage_BuildRawMessageCRLF(t *testing.T) {
+	msg := NewMessage("sender@example.com", "receiver@example.com", "Test\nSubject", "Line 1\nLine 2\r\nLine 3\n", "")
+	msg.ExtraHeader.Set("X-Test", "value")
+	msg.AddAttachment("1", "text.txt", []byte("attachment\nwith\nbare\nLF\n"))
+	msg.AddAttachment("2", "data.bin", bytes.Repeat([]byte{0, 1, 2, '\n', '\r'}, 100))
+
+	raw, err := msg.BuildRawMessageCRLF()
+	require.NoError(t, err)
+	require.NotEmpty(t, raw)
+	bareLF := bytes.Count(raw, []byte("\n")) - bytes.Count(raw, []byte("\r\n"))
+	require.Zero(t, bareLF, "no bare LF")
+	require.NotContains(t, string(raw), "\r\r\n", "no double converted CR")
+
+	parsed, err := ParseMessage(raw)
+	require.NoError(t, err)
+	require.Len(t, parsed.Attachments, 2)
+	require.Equal(t, msg.Attachments[1].FileData, parsed.Attachments[1].FileData, "binary attachment unchanged")
+}
+
+func TestNormalizeCRLF(t *testing.T) {
+	require.Equal(t, "", string(normalizeCRLF([]byte(""))))
+	require.Equal(t, "\r\n", string(normalizeCRLF([]byte("\n"))))
+	require.Equal(t, "a\r\nb\r\nc", string(normalizeCRLF([]byte("a\nb\r\nc"))))
+	require.Equal(t, "a\r\n\r\n", string(normalizeCRLF([]byte("a\r\n\n"))))
+}
+
+const testInlineImageMessage = "From: sender@example.com\r\n" +
+	"To: receiver@example.com\r\n" +
+	"Subject: Inline\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/related; boundary=\"REL\"\r\n" +
+	"\r\n" +
+	"--REL\r\n" +
+	"Content-Type: text/html; charset=utf-8\r\n" +
+	"\r\n" +
+	"<p>Logo: <img src=\"cid:logo@example.com\"></p>\r\n" +
+	"--REL\r\n" +
+	"Content-Type: image/png\r\n" +
+	"Content-ID: <logo@example.com>\r\n" +
+	"Content-Transfer-Encoding: base64\r\n" +
+	"\r\n" +
+	"iVBORw0KGgo=\r\n" +
+	"--REL--\r\n"
+
+func TestMessage_InlineAttachments(t *testing.T) {
+	msg, err := ParseMessage([]byte(testInlineImageMessage))
+	require.NoError(t, err)
+	inlines := msg.InlineAttachments()
+	require.Len(t, inlines, 1, "related part without disposition")
+	require.Equal(t, "logo@example.com", inlines[0].ContentID)
+	require.Equal(t, "image/png", inlines[0].ContentType)
+	imageData := inlines[0].FileData
+
+	msg.AddAttachment("2", "invoice.pdf", []byte("%PDF-1.4"))
+
+	raw, err := msg.BuildRawMessage()
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "multipart/related")
+
+	reparsed, err := ParseMessage(raw)
+	require.NoError(t, err)
+	inlines = reparsed.InlineAttachments()
+	require.Len(t, inlines, 1, "inline part survives round trip")
+	require.Equal(t, "logo@example.com", inlines[0].ContentID)
+	require.Equal(t, "image/png", inlines[0].ContentType)
+	require.Equal(t, imageData, inlines[0].FileData)
+	require.Len(t, reparsed.Attachments, 2)
+	require.Contains(t, reparsed.BodyHTML.String(), "cid:logo@example.com")
+}
+
+func TestParseMessageFileLimit(t *testing.T) {
+	raw := []byte(testInlineImageMessage)
+	file := fs.NewMemFile("message.eml", raw)
+
+	msg, err := ParseMessageFileLimit(context.Background(), file, int64(len(raw)))
+	require.NoError(t, err)
+	require.Len(t, msg.Attachments, 1)
+
+	_, err = ParseMessageFileLimit(context.Background(), file, int64(len(raw)-1))
+	require.ErrorContains(t, err, "exceeding the limit")
+
+	msg, err = ParseMessageFileLimit(context.Background(), file, 0)
+	require.NoError(t, err, "zero means no limit")
+	require.Len(t, msg.Attachments, 1)
+
+	// Size of streamed file is not known in advance
+	unknownSize := unknownSizeFile{file}
+	msg, err = ParseMessageFileLimit(context.Background(), unknownSize, int64(len(raw)))
+	require.NoError(t, err)
+	require.Len(t, msg.Attachments, 1)
+
+	_, err = ParseMessageFileLimit(context.Background(), unknownSize, int64(len(raw)-1))
+	require.ErrorContains(t, err, "exceeds the limit")
+}
+
+// unknownSizeFile is a file that returns
+// -1 as size like a stream of unknown length.
+type unknownSizeFile struct {
+	fs.MemFile
+}
+
+func (unknownSizeFile) Size() int64 { return -1 }
+
+func TestMaxAttachmentBytes(t *testing.T) {
+	defer func(max int64) { MaxAttachmentBytes = max }(MaxAttachmentBytes)
+
+	msg := NewMessage("sender@example.com", "receiver@example.com", "Attachments", "See attached", nullable.TrimmedString(""))
+	msg.AddAttachment("1", "small.txt", []byte("small"))
+	msg.AddAttachment("2", "large.txt", bytes.Repeat([]byte("large"), 100))
+	raw, err := msg.BuildRawMessage()
+	require.NoError(t, err)
+
+	MaxAttachmentBytes = 100
+	parsed, err := ParseMessage(raw)
+	require.NoError(t, err)
+	require.Len(t, parsed.Attachments, 1)
+	require.Equal(t, "small.txt", parsed.Attachments[0].FileName)
+	require.Len(t, parsed.ParseWarnings, 1)
+	require.Contains(t, parsed.ParseWarnings[0], "large.txt")
+
+	MaxAttachmentBytes = 0
+	parsed, err = ParseMessage(raw)
+	require.NoError(t, err)
+	require.Len(t, parsed.Attachments, 2)
+	require.Empty(t, parsed.ParseWarnings)
+}
+
+func TestMessage_Size(t *testing.T) {
+	msg := NewMessage("sender@example.com", "receiver@example.com", "Size", "Body", "")
+	require.Equal(t, 0, msg.AttachmentsTotalSize())
+	sizeWithoutAttachments, err := msg.Size()
+	require.NoError(t, err)
+
+	data := bytes.Repeat([]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, 3000)
+	msg.AddAttachment("1", "data.bin", data)
+	msg.AddAttachment("2", "text.txt", []byte("Hello"))
+	require.Equal(t, len(data)+5, msg.AttachmentsTotalSize(), "raw size before encoding")
+	require.Equal(t, int64(len(data)), msg.Attachments[0].Size())
+
+	size, err := msg.Size()
+	require.NoError(t, err)
+	raw, err := msg.BuildRawMessage()
+	require.NoError(t, err)
+	require.Equal(t, len(raw), size)
+	encodedAttachments := size - sizeWithoutAttachments
+	require.Greater(t, encodedAttachments, len(data)*4/3, "base64 encoding inflates size")
+}
+
+func TestMessage_ThreadRootID(t *testing.T) {
+	msg := &Message{MessageID: "<own@example.com>"}
+	require.Equal(t, "<own@example.com>", msg.ThreadRootID())
+	require.False(t, msg.IsReply())
+
+	msg.InReplyTo = "<parent@example.com>"
+	require.Equal(t, "<parent@example.com>", msg.ThreadRootID())
+	require.True(t, msg.IsReply())
+
+	msg.References = "<root@example.com>, <parent@example.com>"
+	require.Equal(t, "<root@example.com>", msg.ThreadRootID())
+
+	require.Equal(t, "", (&Message{}).ThreadRootID())
+}
+
+func TestMessage_IsReply(t *testing.T) {
+	require.True(t, (&Message{Subject: "Re: Invoice"}).IsReply())
+	require.True(t, (&Message{Subject: "AW: Rechnung"}).IsReply())
+	require.True(t, (&Message{Subject: "aw:Rechnung"}).IsReply())
+	require.False(t, (&Message{Subject: "Fwd: Invoice"}).IsReply())
+	require.False(t, (&Message{Subject: "WG: Rechnung"}).IsReply())
+	require.False(t, (&Message{Subject: "Invoice: March"}).IsReply())
+	require.False(t, (&Message{Subject: "Rechnung"}).IsReply())
+	require.False(t, (&Message{Subject: "R: Bestellung"}).IsReply())
+}
+
+func TestStripReplyPrefix(t *testing.T) {
+	tests := map[string]string{
+		"":                         "",
+		"Invoice":                  "Invoice",
+		"Re: Invoice":              "Invoice",
+		"RE: Invoice":              "Invoice",
+		"Fwd: Invoice":             "Invoice",
+		"Re: Fwd: re: Invoice":     "Invoice",
+		"AW: Rechnung":             "Rechnung",
+		"WG: AW: Rechnung":         "Rechnung",
+		"aw:wg: Rechnung":          "Rechnung",
+		"  Re:  AW: Fw: Rechnung ": "Rechnung",
+		"Invoice: March":           "Invoice: March",
+		"Re: Invoice: March":       "Invoice: March",
+		"Re:":                      "",
+		"R: Bestellung":            "R: Bestellung",
+		"I: Bestellung":            "I: Bestellung",
+		"TR: Rapport":              "TR: Rapport",
+		"SV: Offert":               "SV: Offert",
+	}
+	for subject, want := range tests {
+		require.Equal(t, want, StripReplyPrefix(subject), "StripReplyPrefix(%q)", subject)
+	}
+}
+
+func TestMessage_BuildRawMessage_FoldedHeaders(t *testing.T) {
+	var refs []string
+	for i := range 20 {
+		refs = append(refs, fmt.Sprintf("<message-%02d.1234567890@mail.example.com>", i))
+	}
+	msg := NewMessage("sender@example.com", "receiver@example.com", strings.Repeat("Long subject ", 20)+"End", "Body", "")
+	msg.MessageID = "<reply@mail.example.com>"
+	msg.References = nullable.TrimmedString(strings.Join(refs, ", "))
+
+	raw, err := msg.BuildRawMessage()
+	require.NoError(t, err)
+	header, _, _ := bytes.Cut(raw, []byte("\r\n\r\n"))
+	for _, line := range strings.Split(string(header), "\r\n") {
+		require.LessOrEqual(t, len(line), 78, "header line length: %q", line)
+	}
+
+	parsed, err := ParseMessage(raw)
+	require.NoError(t, err)
+	require.Equal(t, refs, parsed.ReferencesMessageIDs())
+	require.Equal(t, msg.Subject, parsed.Subject)
+	require.Equal(t, nullable.TrimmedString(strings.Join(refs, " ")), parsed.References, "unfolded with space separated IDs")
+}
+
+func TestMessage_ReferencesMessageIDs(t *testing.T) {
+	tests := map[nullable.TrimmedString][]string{
+		"":                   nil,
+		" , ":                nil,
+		"<a@x>":              {"<a@x>"},
+		"<a@x>, <b@x>":       {"<a@x>", "<b@x>"},
+		"<a@x>,<b@x>":        {"<a@x>", "<b@x>"},
+		"<a@x> <b@x>\t<c@x>": {"<a@x>", "<b@x>", "<c@x>"},
+		"<a@x>\r\n <b@x>":    {"<a@x>", "<b@x>"},
+	}
+	for refs, want := range tests {
+		msg := &Message{References: refs}
+		require.Equal(t, want, msg.ReferencesMessageIDs(), "References: %q", refs)
+	}
+}
+
+func TestMessage_Validate(t *testing.T) {
+	date := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	msg := &Message{
+		Date:    &date,
+		From:    "Sender <sender@example.com>",
+		To:      "recipient@example.com",
+		Cc:      "Copy <copy@example.com>, other@example.com",
+		Subject: "Hello",
+		Body:    "Hello World",
+	}
+	require.NoError(t, msg.Validate())
+
+	onlyBcc := &Message{From: "sender@example.com", Bcc: "hidden@example.com", BodyHTML: "<p>Hi</p>"}
+	require.NoError(t, onlyBcc.Validate(), "only Bcc recipient and HTML body")
+
+	badCc := *msg
+	badCc.Cc = "copy@example.com, not an address"
+	err := badCc.Validate()
+	require.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "Cc: "), "error prefixed with field: %s", err)
+
+	invalid := &Message{From: "invalid", Date: &time.Time{}}
+	err = invalid.Validate()
+	require.Error(t, err)
+	for _, want := range []string{"From: ", "no recipient", "Date: ", "no Body"} {
+		assert.Contains(t, err.Error(), want)
+	}
+
+	require.Error(t, (*Message)(nil).Validate())
+}
+
+func TestMessage_EnsurePlainText(t *testing.T) {
+	msg := &Message{BodyHTML: "<p>Hello</p><p>World<br>again</p>"}
+	msg.EnsurePlainText()
+	require.Equal(t, "Hello\n\nWorld\nagain", msg.Body)
+
+	msg = &Message{Body: "Plain", BodyHTML: "<p>HTML</p>"}
+	msg.EnsurePlainText()
+	require.Equal(t, "Plain", msg.Body, "existing Body is kept")
+
+	msg = &Message{}
+	msg.EnsurePlainText()
+	require.Equal(t, "", msg.Body)
+}
@@ -128,6 +128,93 @@ func ParseAddress(addr string) (mailAddress *mail.Address, err error) {
 	return mailAddress, nil
 }
 
+// ParseAddressStrict parses an email address with the standard
+// net/mail.ParseAddress function and additionally enforces
+// the address part to be an unquoted RFC 5321 dot-atom local part
+// of 7bit ASCII characters with at most 64 characters
+// and a domain with at most 255 characters
+// with labels of at most 63 letters, digits, or hyphens
+// and a top-level domain of at least two letters.
+// Addresses accepted by the lenient ParseAddress
+// like "example.at scanner"@example.at are rejected.
+// The address part is returned in lower case like by ParseAddress.
+func ParseAddressStrict(addr string) (mailAddress *mail.Address, err error) {
+	mailAddress, err = mail.ParseAddress(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid email address %q: %w", addr, err)
+	}
+	at := strings.LastIndexByte(mailAddress.Address, '@')
+	if at < 0 {
+		return nil, fmt.Errorf("invalid email address %q: missing @", addr)
+	}
+	local, domain := mailAddress.Address[:at], mailAddress.Address[at+1:]
+	if err = validateStrictLocalPart(local); err != nil {
+		return nil, fmt.Errorf("invalid email address %q: %w", addr, err)
+	}
+	if err = validateStrictDomain(domain); err != nil {
+		return nil, fmt.Errorf("invalid email address %q: %w", addr, err)
+	}
+	mailAddress.Address = strings.ToLower(mailAddress.Address)
+	return mailAddress, nil
+}
+
+func validateStrictLocalPart(local string) error {
+	if local == "" {
+		return errors.New("empty local part")
+	}
+	if len(local) > 64 {
+		return fmt.Errorf("local part longer than 64 characters: %d", len(local))
+	}
+	if local[0] == '.' || local[len(local)-1] == '.' || strings.Contains(local, "..") {
+		return fmt.Errorf("local part with leading, trailing, or consecutive dots: %q", local)
+	}
+	for _, r := range local {
+		isAtext := r < unicode.MaxASCII && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("!#$%&'*+-/=?^_`{|}~.", r))
+		if !isAtext {
+			return fmt.Errorf("invalid character %q in local part %q", r, local)
+		}
+	}
+	return nil
+}
+
+func validateStrictDomain(domain string) error {
+	if len(domain) > 255 {
+		return fmt.Errorf("domain longer than 255 characters: %d", len(domain))
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("domain without top-level domain: %q", domain)
+	}
+	for _, label := range labels {
+		if label == "" {
+			return fmt.Errorf("empty label in domain %q", domain)
+		}
+		if len(label) > 63 {
+			return fmt.Errorf("domain label longer than 63 characters: %q", label)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("domain label with leading or trailing hyphen: %q", label)
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return fmt.Errorf("invalid character %q in domain %q", r, domain)
+			}
+		}
+	}
+	tld := labels[len(labels)-1]
+	if !strings.HasPrefix(strings.ToLower(tld), "xn--") {
+		if len(tld) < 2 {
+			return fmt.Errorf("top-level domain shorter than 2 characters: %q", tld)
+		}
+		for _, r := range tld {
+			if !unicode.IsLetter(r) {
+				return fmt.Errorf("top-level domain with non letter characters: %q", tld)
+			}
+		}
+	}
+	return nil
+}
+
 func parseAddress(addr string) (mailAddress *mail.Address, unparsed string, err error) {
 	i := nameAddressRegexp.FindStringSubmatchIndex(addr)
 	if len(i) != 10 {
@@ -187,18 +274,50 @@ func parseAddress(addr string) (mailAddress *mail.Address, unparsed string, err
 	return mailAddress, unparsed, nil
 }
 
+// UndisclosedRecipientsPlaceholders are lower case prefixes
+// of address lists used by mail clients instead of
+// actual addresses when all recipients are in Bcc.
+var UndisclosedRecipientsPlaceholders = []string{
+	"undisclosed-recipients",
+	"undisclosed recipients",
+	// German
+	"empfänger ungenannt",
+	"empfaenger ungenannt",
+	"ungenannte empfänger",
+	"nicht offengelegte empfänger",
+	// French
+	"destinataires non divulgués",
+	"destinataires non divulgues",
+	"destinataires inconnus",
+}
+
+// IsUndisclosedRecipientsPlaceholder returns if the passed
+// address list is empty or starts with one of the
+// UndisclosedRecipientsPlaceholders (case insensitive).
+func IsUndisclosedRecipientsPlaceholder(list string) bool {
+	list = strings.ToLower(strutil.TrimSpace(list))
+	if list == "" {
+		return true
+	}
+	for _, placeholder := range UndisclosedRecipientsPlaceholders {
+		if strings.HasPrefix(list, placeholder) {
+			return true
+		}
+	}
+	return false
+}
+
 // ParseAddressList parses an email address list less strict
 // than the standard net/mail.ParseAddressList function
 // fixing malformed addresses and lower cases the address part.
 // ParseAddressList returns an error if list does not contain
 // at least one address.
+// An empty list or a localized "undisclosed recipients" placeholder
+// (see IsUndisclosedRecipientsPlaceholder) returns nil without error.
 func ParseAddressList(list string) (addrs []*mail.Address, err error) {
 	list = strings.TrimRight(sanitizeAddr(list), ", ")
 
-	switch ll := strings.ToLower(list); {
-	case ll == "",
-		strings.HasPrefix(ll, "undisclosed-recipients"),
-		strings.HasPrefix(ll, "undisclosed recipients"):
+	if IsUndisclosedRecipientsPlaceholder(list) {
 		return nil, nil
 	}
 
@@ -161,6 +161,68 @@ func TestParseAddress(t *testing.T) {
 	}
 }
 
+func TestParseAddressStrict(t *testing.T) {
+	valid := map[string]*mail.Address{
+		`erik@domonda.com`:                       {Name: "", Address: "erik@domonda.com"},
+		`Erik Unger <Erik.Unger@Domonda.com>`:    {Name: "Erik Unger", Address: "erik.unger@domonda.com"},
+		`"Unger, Erik" <u.erik@domonda.com>`:     {Name: "Unger, Erik", Address: "u.erik@domonda.com"},
+		`er+bill@mail-billwerk.co.uk`:            {Name: "", Address: "er+bill@mail-billwerk.co.uk"},
+		`xy=erik@example.com`:                    {Name: "", Address: "xy=erik@example.com"},
+		`wow@xx.consulting`:                      {Name: "", Address: "wow@xx.consulting"},
+		`info@xn--bro-hoa.de`:                    {Name: "", Address: "info@xn--bro-hoa.de"},
+		strings.Repeat("a", 64) + `@example.com`: {Name: "", Address: strings.Repeat("a", 64) + "@example.com"},
+	}
+	for addr, expected := range valid {
+		t.Run(addr, func(t *testing.T) {
+			result, err := ParseAddressStrict(addr)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+	}
+
+	// Accepted by the lenient ParseAddress but not strictly valid
+	lenientOnly := []string{
+		`"scanner@" <"example.at scanner"@example.at>`,
+		`"Unger, Erik" <"Unger, Erik"@domonda.com>`,
+		`<'stupid@quoting.me'>`,
+		`YouWon't@belivethisßällm.bHt`,
+		`"alte.mücke@united-b.de" <alte.mücke@united-b.de>`,
+		`Domonda < er+vk+baurauslagen+wirklich@domonda.com >`,
+	}
+	for _, addr := range lenientOnly {
+		t.Run(addr, func(t *testing.T) {
+			_, err := ParseAddress(addr)
+			assert.NoError(t, err, "lenient")
+			_, err = ParseAddressStrict(addr)
+			assert.Error(t, err, "strict")
+		})
+	}
+
+	invalid := []string{
+		``,
+		`erik@`,
+		`@domonda.com`,
+		`erik@localhost`,
+		`erik.@domonda.com`,
+		`er..ik@domonda.com`,
+		`erik@-domonda.com`,
+		`erik@domonda-.com`,
+		`erik@domonda..com`,
+		`erik@domonda.c`,
+		`erik@domonda.c0m`,
+		`erik@domonda.123`,
+		strings.Repeat("a", 65) + `@example.com`,
+		`erik@` + strings.Repeat("a", 64) + `.com`,
+		`erik@` + strings.Repeat(strings.Repeat("a", 63)+".", 4) + `com`,
+	}
+	for _, addr := range invalid {
+		t.Run(addr, func(t *testing.T) {
+			_, err := ParseAddressStrict(addr)
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestFindAllAddresses(t *testing.T) {
 	tests := []struct {
 		text string
@@ -212,6 +274,12 @@ func TestParseAddressList(t *testing.T) {
 		"Undisclosed Recipients",
 		"undisclosed-recipients:;",
 		"Undisclosed-recipients:;",
+		"Empfänger ungenannt",
+		"Empfänger ungenannt:;",
+		"EMPFÄNGER UNGENANNT",
+		"destinataires non divulgués",
+		"Destinataires non divulgués:;",
+		"Destinataires inconnus:;",
 	}
 	for _, tt := range emptyLists {
 		parsed, err := ParseAddressList(tt)
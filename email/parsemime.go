@@ -104,6 +104,25 @@ func ParseMIMEMessage(reader io.Reader) (msg *Message, err error) {
 			},
 		})
 	}
+	for _, part := range envelope.OtherParts {
+		if part.ContentID == "" {
+			continue
+		}
+		// Parts of a multipart/related message without
+		// Content-Disposition that are referenced by their
+		// Content-ID from the HTML body are inline attachments
+		msg.Attachments = append(msg.Attachments, &Attachment{
+			PartID:      part.PartID,
+			ContentID:   part.ContentID,
+			ContentType: part.ContentType,
+			Inline:      true,
+			MemFile: fs.MemFile{
+				FileName: part.FileName,
+				FileData: part.Content,
+			},
+		})
+	}
+	msg.removeOversizedAttachments()
 
 	return msg, nil
 }
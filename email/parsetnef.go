@@ -44,6 +44,7 @@ func ParseTNEFMessageBytes(messageBytes []byte) (msg *Message, err error) {
 			MemFile: fs.MemFile{FileName: attachment.Title, FileData: attachment.Data},
 		})
 	}
+	msg.removeOversizedAttachments()
 
 	return msg, nil
 }
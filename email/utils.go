@@ -19,6 +19,66 @@ func plaintextToHTML(text string) template.HTML {
 	return template.HTML(strings.ReplaceAll(text, "\n", "<br>")) //#nosec G203 -- not escaped HTML OK
 }
 
+// htmlToPlaintext is the inverse of plaintextToHTML
+// and converts html to a readable plaintext.
+// Unlike HTMLToPlaintext it converts br elements
+// to line breaks, block elements like p and li
+// to line breaks or empty lines between paragraphs,
+// and collapses whitespace within text.
+// The content of head, script, and style elements is ignored.
+// In case of an HTML parsing error the text
+// parsed up until the error is returned.
+func htmlToPlaintext(html string) string {
+	var (
+		b        strings.Builder
+		newlines int  // line breaks to write before the next text
+		space    bool // space to write before the next text
+		skip     int  // depth of ignored elements
+	)
+	tokenizer := xhtml.NewTokenizer(strings.NewReader(html))
+	for tt := tokenizer.Next(); tt != xhtml.ErrorToken; tt = tokenizer.Next() {
+		if tt == xhtml.TextToken {
+			text := string(tokenizer.Text())
+			words := strings.Fields(text)
+			if skip > 0 || len(words) == 0 {
+				space = space || text != ""
+				continue
+			}
+			if b.Len() > 0 {
+				switch {
+				case newlines > 0:
+					b.WriteString(strings.Repeat("\n", newlines))
+				case space || strutil.IsSpace(rune(text[0])):
+					b.WriteByte(' ')
+				}
+			}
+			b.WriteString(strings.Join(words, " "))
+			newlines = 0
+			space = strutil.IsSpace(rune(text[len(text)-1]))
+			continue
+		}
+		name, _ := tokenizer.TagName()
+		switch string(name) {
+		case "head", "script", "style":
+			switch tt {
+			case xhtml.StartTagToken:
+				skip++
+			case xhtml.EndTagToken:
+				skip = max(skip-1, 0)
+			}
+		case "br":
+			if tt != xhtml.EndTagToken {
+				newlines++
+			}
+		case "p", "h1", "h2", "h3", "h4", "h5", "h6", "ul", "ol", "table", "blockquote", "pre", "hr":
+			newlines = max(newlines, 2)
+		case "div", "li", "tr", "dt", "dd":
+			newlines = max(newlines, 1)
+		}
+	}
+	return b.String()
+}
+
 var parseDateLayouts = []string{
 	"02 Jan 2006 15:04:05 -0700",
 	"Mon, 02 Jan 2006 15:04:05 -0700",
@@ -51,6 +51,41 @@ func TestHTMLToPlaintext(t *testing.T) {
 	}
 }
 
+func Test_htmlToPlaintext(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{name: "empty", html: ``, want: ``},
+		{name: "text", html: `Hello &amp; welcome`, want: `Hello & welcome`},
+		{name: "br", html: `Line 1<br>Line 2<br/>Line 3`, want: "Line 1\nLine 2\nLine 3"},
+		{name: "paragraphs", html: "<p>First  paragraph</p>\n  <p>Second<br>paragraph</p>", want: "First paragraph\n\nSecond\nparagraph"},
+		{name: "inline", html: `<p>Hello <b>bold</b> <i>World</i>!</p>`, want: `Hello bold World!`},
+		{
+			name: "document",
+			html: `<html><head><title>Title</title><style>p { color: red; }</style></head>
+<body>
+	<h1>Invoice</h1>
+	<p>Dear customer,<br>please find the items:</p>
+	<ul>
+		<li>One &euro; item</li>
+		<li>Another item</li>
+	</ul>
+	<div>Best regards</div>
+</body></html>`,
+			want: "Invoice\n\nDear customer,\nplease find the items:\n\nOne € item\nAnother item\n\nBest regards",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := htmlToPlaintext(tt.html); got != tt.want {
+				t.Errorf("htmlToPlaintext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_parseDate(t *testing.T) {
 	cetLocation, err := time.LoadLocation("CET")
 	if err != nil {
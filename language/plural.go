@@ -0,0 +1,70 @@
+package language
+
+// PluralCategory is a CLDR plural category
+// used to select the grammatical form for a count.
+// See https://cldr.unicode.org/index/cldr-spec/plural-rules
+type PluralCategory string
+
+const (
+	PluralZero  PluralCategory = "zero"
+	PluralOne   PluralCategory = "one"
+	PluralTwo   PluralCategory = "two"
+	PluralFew   PluralCategory = "few"
+	PluralMany  PluralCategory = "many"
+	PluralOther PluralCategory = "other"
+)
+
+// PluralCategory returns the CLDR plural category
+// of the integer count n for the language.
+//
+// Rules are implemented for EN, DE, FR, PL, and RU.
+// Other languages use the English rules
+// with PluralOne for 1 and PluralOther else.
+func (c Code) PluralCategory(n int) PluralCategory {
+	if n < 0 {
+		n = -n
+	}
+	norm, _ := c.Normalized()
+	switch norm {
+	case FR:
+		switch {
+		case n == 0 || n == 1:
+			return PluralOne
+		case n%1000000 == 0:
+			return PluralMany
+		}
+		return PluralOther
+
+	case PL:
+		switch {
+		case n == 1:
+			return PluralOne
+		case isPluralFew(n):
+			return PluralFew
+		}
+		return PluralMany
+
+	case RU:
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return PluralOne
+		case isPluralFew(n):
+			return PluralFew
+		}
+		return PluralMany
+
+	default:
+		if n == 1 {
+			return PluralOne
+		}
+		return PluralOther
+	}
+}
+
+// isPluralFew implements the "few" rule shared by
+// Slavic languages: the last digit is 2 to 4,
+// but the last two digits are not 12 to 14.
+func isPluralFew(n int) bool {
+	mod10, mod100 := n%10, n%100
+	return mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14)
+}
@@ -0,0 +1,45 @@
+package language
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCode_PluralCategory(t *testing.T) {
+	tests := []struct {
+		lang Code
+		n    int
+		want PluralCategory
+	}{
+		{EN, 0, PluralOther},
+		{EN, 1, PluralOne},
+		{EN, 2, PluralOther},
+		{EN, -1, PluralOne},
+		{DE, 1, PluralOne},
+		{DE, 5, PluralOther},
+		{"DE", 1, PluralOne},
+		{FR, 0, PluralOne},
+		{FR, 1, PluralOne},
+		{FR, 2, PluralOther},
+		{FR, 1000000, PluralMany},
+		{PL, 1, PluralOne},
+		{PL, 2, PluralFew},
+		{PL, 4, PluralFew},
+		{PL, 5, PluralMany},
+		{PL, 12, PluralMany},
+		{PL, 21, PluralMany},
+		{PL, 22, PluralFew},
+		{RU, 1, PluralOne},
+		{RU, 11, PluralMany},
+		{RU, 21, PluralOne},
+		{RU, 3, PluralFew},
+		{RU, 14, PluralMany},
+		{RU, 0, PluralMany},
+		{"xx", 1, PluralOne},
+		{"xx", 3, PluralOther},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.lang.PluralCategory(tt.n), "%s.PluralCategory(%d)", tt.lang, tt.n)
+	}
+}
@@ -1,9 +1,11 @@
 package money
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"math/big"
+	"strconv"
 	"strings"
 
 	"github.com/domonda/go-types/float"
@@ -87,6 +89,65 @@ func (a Amount) RoundToDecimals(decimals int) Amount {
 	return Amount(math.Round(float64(a)*pow) / pow)
 }
 
+// RoundToCurrency returns the amount rounded to the
+// decimal places of the minor unit of the passed currency,
+// see Currency.DecimalPlaces.
+// Uses half away from zero rounding like math.Round,
+// so 0.125 EUR is rounded to 0.13 EUR and -0.125 EUR to -0.13 EUR.
+func (a Amount) RoundToCurrency(c Currency) Amount {
+	return a.RoundToDecimals(c.DecimalPlaces())
+}
+
+// Add returns the sum of a and b without rounding
+// to not lose precision for currencies with more
+// than 2 decimal places.
+// Use RoundToCurrency to round the result.
+func (a Amount) Add(b Amount) Amount {
+	return a + b
+}
+
+// Sub returns the difference of a and b without rounding.
+// Use RoundToCurrency to round the result.
+func (a Amount) Sub(b Amount) Amount {
+	return a - b
+}
+
+// Mul returns the amount multiplied by factor without rounding
+// to not lose precision for currencies with other than 2 decimal places.
+// Use RoundToCurrency to round the result half away from zero.
+func (a Amount) Mul(factor float64) Amount {
+	return a * Amount(factor)
+}
+
+// Split distributes the amount rounded to cents
+// into n parts without losing or gaining a cent.
+// The remainder cents are distributed one by one
+// starting with the first part, so splitting 10.00
+// three ways returns [3.34, 3.33, 3.33].
+// Negative amounts are split the same way with negative parts.
+// Returns nil if n is smaller than 1.
+func (a Amount) Split(n int) []Amount {
+	if n < 1 {
+		return nil
+	}
+	total := a.Cents()
+	part := total / int64(n)
+	remainder := total % int64(n) // has the sign of total
+	step := int64(1)
+	if remainder < 0 {
+		step, remainder = -1, -remainder
+	}
+	result := make([]Amount, n)
+	for i := range result {
+		cents := part
+		if int64(i) < remainder {
+			cents += step
+		}
+		result[i] = Amount(float64(cents) / 100)
+	}
+	return result
+}
+
 // String returns the amount rounded to two decimal places
 // formatted with a dot as decimal separator.
 // String implements the fmt.Stringer interface.
@@ -125,6 +186,15 @@ func (a Amount) String() string {
 	// return b.String()
 }
 
+// StringFixed returns the amount rounded half away from zero
+// to the passed number of decimal places and formatted
+// with exactly that many decimals and a dot as decimal separator,
+// so 0.1+0.2 with 2 places is "0.30".
+// StringFixed(2) is identical to String.
+func (a Amount) StringFixed(places int) string {
+	return a.RoundToDecimals(places).Format(0, '.', places)
+}
+
 // GoString returns the amount as string
 // in full float64 precision for debugging
 func (a Amount) GoString() string {
@@ -236,6 +306,27 @@ func (a Amount) Inverted() Amount {
 	return -a
 }
 
+// Neg returns the amount with negated sign.
+// Same as Inverted.
+func (a Amount) Neg() Amount {
+	return -a
+}
+
+// IsZero returns if the amount is zero.
+func (a Amount) IsZero() bool {
+	return a == 0
+}
+
+// IsNegative returns if the amount is smaller than zero.
+func (a Amount) IsNegative() bool {
+	return a < 0
+}
+
+// IsPositive returns if the amount is greater than zero.
+func (a Amount) IsPositive() bool {
+	return a > 0
+}
+
 // WithPosSign returns the amount with a positive sign (abs) if true is passed,
 // or with a negative sign if false is passed.
 func (a Amount) WithPosSign(positive bool) Amount {
@@ -322,6 +413,34 @@ func (a Amount) SplitProportionally(weights []Amount) []Amount {
 	return result
 }
 
+// SplitByPercentages splits the amount into parts
+// of the passed percentages that get rounded to cents.
+// The last part receives the rounding remainder so that
+// the sum of the parts is identical to the amount rounded to cents.
+// An error is returned if a percentage is negative
+// or if the percentages don't sum up to 100.
+func (a Amount) SplitByPercentages(percentages []float64) ([]Amount, error) {
+	if len(percentages) == 0 {
+		return nil, nil
+	}
+	weights := make([]Amount, len(percentages))
+	sum := 0.0
+	for i, percent := range percentages {
+		if percent < 0 || math.IsNaN(percent) || math.IsInf(percent, 0) {
+			return nil, fmt.Errorf("invalid percentage: %v", percent)
+		}
+		weights[i] = Amount(percent)
+		sum += percent
+	}
+	if math.Abs(sum-100) > 1e-9 {
+		return nil, fmt.Errorf("percentages sum up to %v instead of 100", sum)
+	}
+	if len(weights) == 1 {
+		return []Amount{a.RoundToCents()}, nil
+	}
+	return a.SplitProportionally(weights), nil
+}
+
 // Valid returns if the amount is neither infinite nor NaN
 func (a Amount) Valid() bool {
 	return !a.IsInf() && !a.IsNaN()
@@ -361,6 +480,60 @@ func (a Amount) ValidAndHasSign(sign int) bool {
 	return float.ValidAndHasSign(float64(a), sign)
 }
 
+// decimalStringDecimals is the maximum number of decimal places
+// used by Amount.DecimalString, enough for exchange rates and unit prices
+// while removing binary floating point errors like in 0.1+0.2.
+const decimalStringDecimals = 9
+
+// DecimalString returns the amount as decimal string
+// for exact storage in a numeric column like "0.30" for 0.1+0.2.
+// The amount is rounded to 9 decimal places and formatted
+// with two decimals if it has whole cents,
+// else with the minimum number of decimals like "0.125".
+// Infinity and NaN are formatted like by strconv.FormatFloat.
+func (a Amount) DecimalString() string {
+	if !a.Valid() {
+		return strconv.FormatFloat(float64(a), 'f', -1, 64)
+	}
+	rounded := a.RoundToDecimals(decimalStringDecimals)
+	if rounded == rounded.RoundToCents() {
+		return rounded.StringFixed(2)
+	}
+	return strconv.FormatFloat(float64(rounded), 'f', -1, 64)
+}
+
+// Value implements the database/sql/driver.Valuer interface
+// by returning the result of DecimalString for exact storage
+// in a numeric column without binary floating point errors.
+// Note that the decimal string is also rounded to 9 decimal places
+// when stored in a floating point column like double precision.
+func (a Amount) Value() (driver.Value, error) {
+	return a.DecimalString(), nil
+}
+
+// Scan implements the database/sql.Scanner interface
+// for numeric columns returned as decimal strings
+// and for float and integer columns.
+func (a *Amount) Scan(value any) error {
+	switch x := value.(type) {
+	case string:
+		amount, err := ParseAmount(x)
+		if err != nil {
+			return fmt.Errorf("can't scan SQL value %q as money.Amount: %w", x, err)
+		}
+		*a = amount
+	case []byte:
+		return a.Scan(string(x))
+	case float64:
+		*a = Amount(x)
+	case int64:
+		*a = Amount(x)
+	default:
+		return fmt.Errorf("can't scan SQL value of type %T as money.Amount", value)
+	}
+	return nil
+}
+
 // UnmarshalJSON implements encoding/json.Unmarshaler
 // and accepts numbers, strings, and null.
 // JSON null and "" will set the amout to zero.
@@ -251,6 +251,93 @@ func Test_Amount_SplitProportionally(t *testing.T) {
 	}
 }
 
+func TestAmount_SplitByPercentages(t *testing.T) {
+	data := []struct {
+		amount      Amount
+		percentages []float64
+		expected    []Amount
+	}{
+		{100, nil, nil},
+		{100.005, []float64{100}, []Amount{100.01}},
+		{119, []float64{19, 81}, []Amount{22.61, 96.39}},
+		{99.99, []float64{60, 40}, []Amount{59.99, 40}},
+		{0.01, []float64{60, 40}, []Amount{0.01, 0}},
+		{100, []float64{33.33, 33.33, 33.34}, []Amount{33.33, 33.33, 33.34}},
+		{10, []float64{100.0 / 3, 100.0 / 3, 100.0 / 3}, []Amount{3.33, 3.33, 3.34}},
+		{-99.99, []float64{60, 40}, []Amount{-59.99, -40}},
+	}
+	for _, test := range data {
+		result, err := test.amount.SplitByPercentages(test.percentages)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, result, "%v.SplitByPercentages(%v)", test.amount, test.percentages)
+		sum := Amount(0)
+		for _, part := range result {
+			sum += part
+		}
+		if len(result) > 0 {
+			assert.Equal(t, test.amount.RoundToCents(), sum.RoundToCents(), "parts reconcile to the cent")
+		}
+	}
+
+	for _, percentages := range [][]float64{{60, 50}, {50}, {110, -10}, {math.NaN(), 100}} {
+		_, err := Amount(100).SplitByPercentages(percentages)
+		assert.Error(t, err, "SplitByPercentages(%v)", percentages)
+	}
+}
+
+func TestAmount_RoundToCurrency(t *testing.T) {
+	assert.Equal(t, Amount(0.13), Amount(0.125).RoundToCurrency(EUR), "half away from zero")
+	assert.Equal(t, Amount(-0.13), Amount(-0.125).RoundToCurrency(EUR), "half away from zero")
+	assert.Equal(t, Amount(1235), Amount(1234.5).RoundToCurrency(JPY))
+	assert.Equal(t, Amount(1.235), Amount(1.2345).RoundToCurrency(KWD))
+}
+
+func TestAmount_Arithmetic(t *testing.T) {
+	assert.Equal(t, Amount(0.3), Amount(0.1).Add(0.2).RoundToCurrency(EUR))
+	assert.Equal(t, Amount(0.1), Amount(0.3).Sub(0.2).RoundToCurrency(EUR))
+	assert.Equal(t, Amount(-1.5), Amount(1).Sub(2.5))
+	assert.Equal(t, Amount(0.002), Amount(0.001).Add(0.001), "no rounding to cents")
+	assert.Equal(t, Amount(0.00000001), Amount(0.00000003).Sub(0.00000002).RoundToCurrency(BTC))
+	assert.Equal(t, Amount(3.33), Amount(10).Mul(1.0/3).RoundToCurrency(EUR))
+	assert.Equal(t, Amount(-0.13), Amount(-0.25).Mul(0.5).RoundToCurrency(EUR), "half away from zero")
+	assert.Equal(t, Amount(-0.125), Amount(-0.25).Mul(0.5), "no rounding to cents")
+	assert.Equal(t, Amount(1.235), Amount(2.47).Mul(0.5).RoundToCurrency(KWD))
+	assert.Equal(t, Amount(617), Amount(1234).Mul(0.5).RoundToCurrency(JPY))
+	assert.Equal(t, Amount(0.00000003), Amount(0.00000005).Mul(0.5).RoundToCurrency(BTC))
+}
+
+func TestAmount_Split(t *testing.T) {
+	data := []struct {
+		amount   Amount
+		n        int
+		expected []Amount
+	}{
+		{10, 0, nil},
+		{10, 1, []Amount{10}},
+		{10, 3, []Amount{3.34, 3.33, 3.33}},
+		{100, 3, []Amount{33.34, 33.33, 33.33}},
+		{0.05, 3, []Amount{0.02, 0.02, 0.01}},
+		{0.01, 3, []Amount{0.01, 0, 0}},
+		{1, 17, []Amount{0.06, 0.06, 0.06, 0.06, 0.06, 0.06, 0.06, 0.06, 0.06, 0.06, 0.06, 0.06, 0.06, 0.06, 0.06, 0.05, 0.05}},
+		{-10, 3, []Amount{-3.34, -3.33, -3.33}},
+		{100.005, 2, []Amount{50.01, 50}},
+	}
+	for _, test := range data {
+		result := test.amount.Split(test.n)
+		assert.Equal(t, test.expected, result, "%v.Split(%d)", test.amount, test.n)
+	}
+
+	for i := 0; i < 1000; i++ {
+		amount := Amount(rand.Int63n(2_000_000)-1_000_000) / 100
+		n := 1 + rand.Intn(20)
+		sumCents := int64(0)
+		for _, part := range amount.Split(n) {
+			sumCents += part.Cents()
+		}
+		assert.Equal(t, amount.Cents(), sumCents, "%v.Split(%d) sums up exactly", amount, n)
+	}
+}
+
 func TestAmount_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -317,3 +404,66 @@ func TestAmount_GoString(t *testing.T) {
 		}
 	}
 }
+
+func TestAmount_StringFixed(t *testing.T) {
+	assert.Equal(t, "0.30", (Amount(0.1) + Amount(0.2)).StringFixed(2))
+	assert.Equal(t, "0.300", (Amount(0.1) + Amount(0.2)).StringFixed(3))
+	assert.Equal(t, "0", Amount(0.4).StringFixed(0))
+	assert.Equal(t, "1.13", Amount(1.125).StringFixed(2), "half away from zero")
+	assert.Equal(t, "-1.13", Amount(-1.125).StringFixed(2), "half away from zero")
+	assert.Equal(t, "1234.5678", Amount(1234.5678).StringFixed(4))
+	assert.Equal(t, Amount(12.3).String(), Amount(12.3).StringFixed(2))
+}
+
+func TestAmount_DecimalString(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		want   string
+	}{
+		{amount: Amount(0.1) + Amount(0.2), want: "0.30"},
+		{amount: 0, want: "0.00"},
+		{amount: -12.5, want: "-12.50"},
+		{amount: 1234567.89, want: "1234567.89"},
+		{amount: 0.125, want: "0.125"},
+		{amount: 1.23456789012, want: "1.23456789"},
+		{amount: Amount(math.Inf(1)), want: "+Inf"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.amount.DecimalString())
+		if tt.amount.Valid() {
+			var scanned Amount
+			assert.NoError(t, scanned.Scan(tt.want))
+			assert.Equal(t, tt.amount.RoundToDecimals(decimalStringDecimals), scanned, "round-trip of %s", tt.want)
+		}
+	}
+}
+
+func TestAmount_SQL(t *testing.T) {
+	value, err := (Amount(0.1) + Amount(0.2)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.30", value)
+	var scanned Amount
+	assert.NoError(t, scanned.Scan(value))
+	assert.Equal(t, "0.30", scanned.StringFixed(2))
+	assert.Equal(t, Amount(0.3), scanned)
+
+	for _, amount := range []Amount{0, -12.5, 1.25, 1234567.89, -0.001} {
+		value, err := amount.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, amount.DecimalString(), value)
+
+		var scanned Amount
+		assert.NoError(t, scanned.Scan(value))
+		assert.Equal(t, amount, scanned, "round-trip of %v", amount)
+	}
+
+	var a Amount
+	assert.NoError(t, a.Scan("1.2345678901"))
+	assert.Equal(t, Amount(1.2345678901), a, "10 decimals from numeric column")
+	assert.NoError(t, a.Scan([]byte("0.30")))
+	assert.Equal(t, Amount(0.3), a)
+	assert.NoError(t, a.Scan(int64(42)))
+	assert.Equal(t, Amount(42), a)
+	assert.Error(t, a.Scan(nil))
+	assert.Error(t, a.Scan("not a number"))
+}
@@ -1,5 +1,13 @@
 package money
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/domonda/go-types/language"
+)
+
 // CurrencyNull represents the SQL NULL for Currency and NullableCurrency.
 // Currency(CurrencyNull).Valid() == false
 // NullableCurrency(CurrencyNull).Valid() == true
@@ -169,9 +177,21 @@ const (
 	ZMW = "ZMW" // Zambia Kwacha
 	ZWD = "ZWD" // Zimbabwe Dollar
 
-	BTC = "BTC" // Bitcoin
+	BTC  = "BTC"  // Bitcoin
+	ETH  = "ETH"  // Ether
+	USDT = "USDT" // Tether
+	USDC = "USDC" // USD Coin
 )
 
+// cryptoCurrencies holds the supported crypto currencies
+// that have no ISO 4217 code.
+var cryptoCurrencies = map[Currency]struct{}{
+	BTC:  {},
+	ETH:  {},
+	USDT: {},
+	USDC: {},
+}
+
 var currencySymbolToCode = map[string]Currency{
 	"€":    EUR,
 	"$":    USD,
@@ -189,6 +209,7 @@ var currencySymbolToCode = map[string]Currency{
 	"kn":   HRK,
 	"¥":    JPY,
 	"₿":    BTC,
+	"Ξ":    ETH,
 }
 
 var currencyCodeToSymbol = map[Currency]string{
@@ -362,4 +383,287 @@ var currencyCodeToName = map[Currency]string{
 	ZAR: "South Africa Rand",
 	ZMW: "Zambia Kwacha",
 	ZWD: "Zimbabwe Dollar",
+
+	BTC:  "Bitcoin",
+	ETH:  "Ether",
+	USDT: "Tether",
+	USDC: "USD Coin",
+}
+
+// currencyDecimalDigits holds the number of decimal digits
+// of the minor unit for currencies that don't have 2
+// according to ISO 4217.
+var currencyDecimalDigits = map[Currency]int{
+	BIF: 0,
+	CLP: 0,
+	DJF: 0,
+	GNF: 0,
+	ISK: 0,
+	JPY: 0,
+	KMF: 0,
+	KRW: 0,
+	PYG: 0,
+	RWF: 0,
+	UGX: 0,
+	VND: 0,
+	VUV: 0,
+	XAF: 0,
+	XOF: 0,
+	XPF: 0,
+
+	BHD: 3,
+	IQD: 3,
+	JOD: 3,
+	KWD: 3,
+	LYD: 3,
+	OMR: 3,
+	TND: 3,
+
+	BTC:  8,  // Satoshi
+	ETH:  18, // Wei
+	USDT: 6,
+	USDC: 6,
+}
+
+// currencyNumericCodes holds the ISO 4217 numeric codes.
+// Currencies without ISO 4217 code like BTC
+// or the GGP, IMP, JEP, SPL, TVD local issues are not included.
+var currencyNumericCodes = map[Currency]int{
+	AED: 784,
+	AFN: 971,
+	ALL: 8,
+	AMD: 51,
+	ANG: 532,
+	AOA: 973,
+	ARS: 32,
+	AUD: 36,
+	AWG: 533,
+	AZN: 944,
+	BAM: 977,
+	BBD: 52,
+	BDT: 50,
+	BGN: 975,
+	BHD: 48,
+	BIF: 108,
+	BMD: 60,
+	BND: 96,
+	BOB: 68,
+	BRL: 986,
+	BSD: 44,
+	BTN: 64,
+	BWP: 72,
+	BYN: 933,
+	BZD: 84,
+	CAD: 124,
+	CDF: 976,
+	CHF: 756,
+	CLP: 152,
+	CNY: 156,
+	COP: 170,
+	CRC: 188,
+	CUC: 931,
+	CUP: 192,
+	CVE: 132,
+	CZK: 203,
+	DJF: 262,
+	DKK: 208,
+	DOP: 214,
+	DZD: 12,
+	EGP: 818,
+	ERN: 232,
+	ETB: 230,
+	EUR: 978,
+	FJD: 242,
+	FKP: 238,
+	GBP: 826,
+	GEL: 981,
+	GHS: 936,
+	GIP: 292,
+	GMD: 270,
+	GNF: 324,
+	GTQ: 320,
+	GYD: 328,
+	HKD: 344,
+	HNL: 340,
+	HRK: 191,
+	HTG: 332,
+	HUF: 348,
+	IDR: 360,
+	ILS: 376,
+	INR: 356,
+	IQD: 368,
+	IRR: 364,
+	ISK: 352,
+	JMD: 388,
+	JOD: 400,
+	JPY: 392,
+	KES: 404,
+	KGS: 417,
+	KHR: 116,
+	KMF: 174,
+	KPW: 408,
+	KRW: 410,
+	KWD: 414,
+	KYD: 136,
+	KZT: 398,
+	LAK: 418,
+	LBP: 422,
+	LKR: 144,
+	LRD: 430,
+	LSL: 426,
+	LYD: 434,
+	MAD: 504,
+	MDL: 498,
+	MGA: 969,
+	MKD: 807,
+	MMK: 104,
+	MNT: 496,
+	MOP: 446,
+	MRO: 478, // Withdrawn
+	MUR: 480,
+	MVR: 462,
+	MWK: 454,
+	MXN: 484,
+	MYR: 458,
+	MZN: 943,
+	NAD: 516,
+	NGN: 566,
+	NIO: 558,
+	NOK: 578,
+	NPR: 524,
+	NZD: 554,
+	OMR: 512,
+	PAB: 590,
+	PEN: 604,
+	PGK: 598,
+	PHP: 608,
+	PKR: 586,
+	PLN: 985,
+	PYG: 600,
+	QAR: 634,
+	RON: 946,
+	RSD: 941,
+	RUB: 643,
+	RWF: 646,
+	SAR: 682,
+	SBD: 90,
+	SCR: 690,
+	SDG: 938,
+	SEK: 752,
+	SGD: 702,
+	SHP: 654,
+	SLL: 694,
+	SOS: 706,
+	SRD: 968,
+	STD: 678, // Withdrawn
+	SVC: 222,
+	SYP: 760,
+	SZL: 748,
+	THB: 764,
+	TJS: 972,
+	TMT: 934,
+	TND: 788,
+	TOP: 776,
+	TRY: 949,
+	TTD: 780,
+	TWD: 901,
+	TZS: 834,
+	UAH: 980,
+	UGX: 800,
+	USD: 840,
+	UYU: 858,
+	UZS: 860,
+	VEF: 937, // Withdrawn
+	VND: 704,
+	VUV: 548,
+	WST: 882,
+	XAF: 950,
+	XCD: 951,
+	XDR: 960,
+	XOF: 952,
+	XPF: 953,
+	YER: 886,
+	ZAR: 710,
+	ZMW: 967,
+	ZWD: 716, // Withdrawn
+}
+
+// currencyFromNumericCode is the inverse of currencyNumericCodes
+var currencyFromNumericCode = make(map[int]Currency, len(currencyNumericCodes))
+
+func init() {
+	for c, n := range currencyNumericCodes {
+		currencyFromNumericCode[n] = c
+	}
+}
+
+type currencyLocale struct {
+	thousandsSep rune
+	decimalSep   rune
+	symbolFirst  bool
+}
+
+// currencyLocales holds the number format and currency
+// symbol placement for amounts in a language.
+var currencyLocales = map[language.Code]currencyLocale{
+	language.EN: {thousandsSep: ',', decimalSep: '.', symbolFirst: true},
+	language.DE: {thousandsSep: '.', decimalSep: ',', symbolFirst: false},
+	language.FR: {thousandsSep: ' ', decimalSep: ',', symbolFirst: false},
+	language.IT: {thousandsSep: '.', decimalSep: ',', symbolFirst: false},
+	language.ES: {thousandsSep: '.', decimalSep: ',', symbolFirst: false},
+}
+
+// verifyCurrencyTables checks that the currency tables
+// are consistent with each other: every currency with
+// a symbol or decimal digits must have a name,
+// every named currency must be a valid ISO 4217 style code
+// with a supported number of decimal digits.
+// Crypto currencies may have codes with 3 to 5 letters
+// and up to 18 decimal digits.
+func verifyCurrencyTables() (err error) {
+	for c, name := range currencyCodeToName {
+		_, crypto := cryptoCurrencies[c]
+		maxLen, maxDigits := 3, 8
+		if crypto {
+			maxLen, maxDigits = 5, 18
+		}
+		if len(c) < 3 || len(c) > maxLen || strings.ToUpper(string(c)) != string(c) {
+			err = errors.Join(err, fmt.Errorf("invalid currency code %q in currencyCodeToName", c))
+		}
+		if name == "" {
+			err = errors.Join(err, fmt.Errorf("empty name for currency %s", c))
+		}
+		if digits := c.DecimalDigits(); digits < 0 || digits > maxDigits {
+			err = errors.Join(err, fmt.Errorf("invalid decimal digits %d for currency %s", digits, c))
+		}
+	}
+	for c := range cryptoCurrencies {
+		if _, ok := currencyCodeToName[c]; !ok {
+			err = errors.Join(err, fmt.Errorf("crypto currency %s has no name", c))
+		}
+	}
+	for c := range currencyDecimalDigits {
+		if _, ok := currencyCodeToName[c]; !ok {
+			err = errors.Join(err, fmt.Errorf("currency %s in currencyDecimalDigits has no name", c))
+		}
+	}
+	for c := range currencyNumericCodes {
+		if _, ok := currencyCodeToName[c]; !ok {
+			err = errors.Join(err, fmt.Errorf("currency %s in currencyNumericCodes has no name", c))
+		}
+	}
+	for symbol, c := range currencySymbolToCode {
+		if _, ok := currencyCodeToName[c]; !ok {
+			err = errors.Join(err, fmt.Errorf("symbol %q maps to currency %s without name", symbol, c))
+		}
+	}
+	for c, symbol := range currencyCodeToSymbol {
+		if _, ok := currencyCodeToName[c]; !ok {
+			err = errors.Join(err, fmt.Errorf("currency %s with symbol %q has no name", c, symbol))
+		}
+		if currencySymbolToCode[symbol] != c {
+			err = errors.Join(err, fmt.Errorf("symbol %q of currency %s does not map back to it", symbol, c))
+		}
+	}
+	return err
 }
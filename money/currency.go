@@ -4,8 +4,11 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
+	"github.com/domonda/go-types/language"
 	"github.com/domonda/go-types/strutil"
 )
 
@@ -132,6 +135,14 @@ func (c Currency) IsEUR() bool {
 	return norm == EUR
 }
 
+// IsCrypto returns if the currency can be normalized
+// as crypto currency like BTC or ETH.
+func (c Currency) IsCrypto() bool {
+	norm, _ := c.Normalized()
+	_, ok := cryptoCurrencies[norm]
+	return ok
+}
+
 // Scan implements the database/sql.Scanner interface.
 func (c *Currency) Scan(value any) error {
 	switch x := value.(type) {
@@ -169,6 +180,135 @@ func (c Currency) EnglishName() string {
 	return currencyCodeToName[c]
 }
 
+// Numeric returns the ISO 4217 numeric code
+// of the currency, like 978 for EUR,
+// or zero for unknown currencies.
+func (c Currency) Numeric() int {
+	norm, _ := c.Normalized()
+	return currencyNumericCodes[norm]
+}
+
+// CurrencyFromNumeric returns the Currency for an
+// ISO 4217 numeric code like 978 for EUR.
+// Zero and unknown numeric codes return false.
+func CurrencyFromNumeric(n int) (Currency, bool) {
+	c, ok := currencyFromNumericCode[n]
+	return c, ok
+}
+
+// DecimalDigits returns the number of decimal digits
+// of the minor unit of the currency, like 2 for the cents of EUR,
+// 0 for JPY, or 8 for the Satoshi of BTC.
+// Returns 2 for unknown currencies.
+func (c Currency) DecimalDigits() int {
+	norm, _ := c.Normalized()
+	if digits, ok := currencyDecimalDigits[norm]; ok {
+		return digits
+	}
+	return 2
+}
+
+// DecimalPlaces returns the number of decimal places
+// of the minor unit of the currency.
+// Same as DecimalDigits.
+func (c Currency) DecimalPlaces() int {
+	return c.DecimalDigits()
+}
+
+// Format returns the amount formatted with the decimal digits
+// of the currency and the separators and currency symbol placement
+// of the language, like "$1,234.56" for USD in English
+// or "1.234,56 €" for EUR in German.
+// The currency code is used if the currency has no symbol.
+// Unsupported languages are formatted like English.
+// At most maxFormatDecimals decimals are formatted
+// because float64 can't represent more for usual amounts,
+// so ETH is formatted with 8 instead of 18 decimals.
+func (c Currency) Format(amount Amount, lang language.Code) string {
+	if norm, err := c.Normalized(); err == nil {
+		c = norm
+	}
+	lang, _ = lang.Normalized()
+	loc, ok := currencyLocales[lang]
+	if !ok {
+		loc = currencyLocales[language.EN]
+	}
+	digits := min(c.DecimalDigits(), maxFormatDecimals)
+	amountStr := amount.RoundToDecimals(digits).Abs().Format(loc.thousandsSep, loc.decimalSep, digits)
+	sign := ""
+	if amount.RoundToDecimals(digits) < 0 {
+		sign = "-"
+	}
+	if c == "" {
+		return sign + amountStr
+	}
+	symbol := c.Symbol()
+	if !loc.symbolFirst {
+		return sign + amountStr + " " + symbol
+	}
+	if symbol == string(c) {
+		return sign + symbol + " " + amountStr
+	}
+	return sign + symbol + amountStr
+}
+
+// maxFormatDecimals is the maximum number of decimals
+// formatted by Currency.Format.
+const maxFormatDecimals = 8
+
+// ToMinorUnits returns the amount rounded to the
+// minor unit of the currency as integer,
+// like cents for EUR or Satoshi for BTC.
+//
+// Note that float64 can't represent all decimal fractions exactly,
+// so amounts with many decimal digits like crypto currencies
+// should be stored and calculated as minor units
+// in int64 instead of as Amount.
+//
+// Amounts that don't fit into int64, like more than
+// about 9.2 ETH with its 18 decimal digits,
+// return math.MaxInt64 or math.MinInt64 and ok is false.
+// NaN returns zero and false.
+func (c Currency) ToMinorUnits(amount Amount) (units int64, ok bool) {
+	f := math.Round(float64(amount) * math.Pow10(c.DecimalDigits()))
+	switch {
+	case math.IsNaN(f):
+		return 0, false
+	case f >= math.MaxInt64: // float64(math.MaxInt64) is 2^63
+		return math.MaxInt64, false
+	case f < math.MinInt64:
+		return math.MinInt64, false
+	}
+	return int64(f), true
+}
+
+// FromMinorUnits returns the Amount for an integer
+// number of minor units of the currency.
+// See ToMinorUnits for the precision limits of Amount.
+func (c Currency) FromMinorUnits(units int64) Amount {
+	return Amount(float64(units) / math.Pow10(c.DecimalDigits()))
+}
+
+// FormatMinorUnits formats an integer number of
+// minor units of the currency as exact decimal number
+// with a point as decimal separator and DecimalDigits decimals
+// without using floating point arithmetic.
+func (c Currency) FormatMinorUnits(units int64) string {
+	digits := c.DecimalDigits()
+	s := strconv.FormatInt(units, 10)
+	if digits == 0 {
+		return s
+	}
+	sign := ""
+	if units < 0 {
+		sign, s = "-", s[1:]
+	}
+	if len(s) <= digits {
+		s = strings.Repeat("0", digits-len(s)+1) + s
+	}
+	return sign + s[:len(s)-digits] + "." + s[len(s)-digits:]
+}
+
 // String returns the normalized currency as string if possible,
 // else it will be returned unchanged as string.
 // String implements the fmt.Stringer interface.
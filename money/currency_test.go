@@ -1,9 +1,15 @@
 package money
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/domonda/go-types/language"
 )
 
 var currencyTestTable = []string{
@@ -47,3 +53,207 @@ func Test_NullCurrency(t *testing.T) {
 	assert.False(t, Currency("").Valid())
 	assert.True(t, NullableCurrency("").Valid())
 }
+
+func TestCurrency_DecimalDigits(t *testing.T) {
+	assert.Equal(t, 2, Currency(EUR).DecimalDigits())
+	assert.Equal(t, 0, Currency(JPY).DecimalDigits())
+	assert.Equal(t, 3, Currency(KWD).DecimalDigits())
+	assert.Equal(t, 8, Currency(BTC).DecimalDigits())
+	assert.Equal(t, 8, Currency("₿").DecimalDigits())
+	assert.Equal(t, 2, Currency("XXX").DecimalDigits())
+}
+
+func TestCurrency_MinorUnits(t *testing.T) {
+	btc := Currency(BTC)
+	assert.True(t, btc.Valid())
+
+	satoshi, ok := btc.ToMinorUnits(1.23456789)
+	assert.True(t, ok)
+	assert.Equal(t, int64(123456789), satoshi)
+	assert.Equal(t, Amount(1.23456789), btc.FromMinorUnits(satoshi))
+	assert.Equal(t, "1.23456789", btc.FormatMinorUnits(satoshi))
+	assert.Equal(t, "0.00000001", btc.FormatMinorUnits(1))
+	assert.Equal(t, "-0.00000001", btc.FormatMinorUnits(-1))
+	assert.Equal(t, int64(1), mustMinorUnits(btc.ToMinorUnits(0.00000001)))
+
+	eur := Currency(EUR)
+	assert.Equal(t, int64(-1999), mustMinorUnits(eur.ToMinorUnits(-19.99)))
+	assert.Equal(t, Amount(-19.99), eur.FromMinorUnits(-1999))
+	assert.Equal(t, "-19.99", eur.FormatMinorUnits(-1999))
+	assert.Equal(t, "0.05", eur.FormatMinorUnits(5))
+
+	jpy := Currency(JPY)
+	assert.Equal(t, int64(1235), mustMinorUnits(jpy.ToMinorUnits(1234.5)))
+	assert.Equal(t, "1234", jpy.FormatMinorUnits(1234))
+
+	eth := Currency(ETH)
+	assert.Equal(t, int64(1e18), mustMinorUnits(eth.ToMinorUnits(1)))
+	units, ok := eth.ToMinorUnits(10)
+	assert.False(t, ok, "10 ETH overflows int64 Wei")
+	assert.Equal(t, int64(math.MaxInt64), units)
+	units, ok = eth.ToMinorUnits(-10)
+	assert.False(t, ok, "-10 ETH overflows int64 Wei")
+	assert.Equal(t, int64(math.MinInt64), units)
+	units, ok = eur.ToMinorUnits(Amount(math.NaN()))
+	assert.False(t, ok, "NaN")
+	assert.Equal(t, int64(0), units)
+}
+
+func mustMinorUnits(units int64, ok bool) int64 {
+	if !ok {
+		panic("ToMinorUnits overflow")
+	}
+	return units
+}
+
+func TestVerifyCurrencyTables(t *testing.T) {
+	assert.NoError(t, verifyCurrencyTables())
+
+	// Every currency constant declared in constants.go must have a name
+	file, err := parser.ParseFile(token.NewFileSet(), "constants.go", nil, 0)
+	assert.NoError(t, err)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			for _, name := range spec.(*ast.ValueSpec).Names {
+				if name.Name == "CurrencyNull" {
+					continue
+				}
+				c := Currency(name.Name)
+				assert.NotEmpty(t, c.EnglishName(), "currency constant %s has no name", c)
+				assert.True(t, c.Valid(), "currency constant %s is valid", c)
+			}
+		}
+	}
+}
+
+func TestCurrency_Numeric(t *testing.T) {
+	assert.Equal(t, 978, Currency(EUR).Numeric())
+	assert.Equal(t, 840, Currency(USD).Numeric())
+	assert.Equal(t, 392, Currency(JPY).Numeric())
+	assert.Equal(t, 978, Currency(" eur ").Numeric())
+	assert.Equal(t, 0, Currency(BTC).Numeric())
+	assert.Equal(t, 0, Currency("XXX").Numeric())
+
+	c, ok := CurrencyFromNumeric(978)
+	assert.True(t, ok)
+	assert.Equal(t, Currency(EUR), c)
+	c, ok = CurrencyFromNumeric(840)
+	assert.True(t, ok)
+	assert.Equal(t, Currency(USD), c)
+	_, ok = CurrencyFromNumeric(0)
+	assert.False(t, ok)
+	_, ok = CurrencyFromNumeric(1)
+	assert.False(t, ok)
+
+	assert.Len(t, currencyFromNumericCode, len(currencyNumericCodes), "numeric codes are unique")
+	for cur, n := range currencyNumericCodes {
+		back, ok := CurrencyFromNumeric(n)
+		assert.True(t, ok, "CurrencyFromNumeric(%d)", n)
+		assert.Equal(t, cur, back, "CurrencyFromNumeric(%d)", n)
+		assert.Equal(t, n, cur.Numeric())
+	}
+}
+
+func TestCurrency_Format(t *testing.T) {
+	tests := []struct {
+		currency Currency
+		amount   Amount
+		lang     language.Code
+		want     string
+	}{
+		{EUR, 1234.56, language.DE, "1.234,56 €"},
+		{EUR, 1234.56, language.EN, "€1,234.56"},
+		{EUR, -1234.5, language.DE, "-1.234,50 €"},
+		{EUR, 1234567.891, language.FR, "1 234 567,89 €"},
+		{USD, 1234.56, language.EN, "$1,234.56"},
+		{USD, -0.5, language.EN, "-$0.50"},
+		{USD, 1234.56, "xx", "$1,234.56"},
+		{EUR, 1234.56, "DE", "1.234,56 €"},
+		{JPY, 1234.56, language.EN, "¥1,235"},
+		{JPY, 1234, language.DE, "1.234 ¥"},
+		{KWD, 1234.5678, language.EN, "KWD 1,234.568"},
+		{KWD, 1234.5678, language.DE, "1.234,568 KWD"},
+		{"", 99.999, language.EN, "100.00"},
+		{BTC, 0.1, language.EN, "BTC 0.10000000"},
+		{ETH, 0.1, language.EN, "ETH 0.10000000"},
+		{ETH, 1234.5, language.DE, "1.234,50000000 ETH"},
+		{ETH, -0.123456789, language.EN, "-ETH 0.12345679"},
+		{"kwd", 1234.5678, language.EN, "KWD 1,234.568"},
+		{"eur", 1000, language.EN, "€1,000.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.currency.Format(tt.amount, tt.lang))
+		})
+	}
+}
+
+func TestCurrency_DecimalPlaces(t *testing.T) {
+	tests := map[Currency]int{
+		// ISO 4217 currencies without minor unit
+		BIF: 0,
+		CLP: 0,
+		DJF: 0,
+		GNF: 0,
+		ISK: 0,
+		JPY: 0,
+		KMF: 0,
+		KRW: 0,
+		PYG: 0,
+		RWF: 0,
+		UGX: 0,
+		VND: 0,
+		VUV: 0,
+		XAF: 0,
+		XOF: 0,
+		XPF: 0,
+		// ISO 4217 currencies with 3 decimal places
+		BHD: 3,
+		IQD: 3,
+		JOD: 3,
+		KWD: 3,
+		LYD: 3,
+		OMR: 3,
+		TND: 3,
+		// Non ISO
+		BTC:  8,
+		ETH:  18,
+		USDT: 6,
+		USDC: 6,
+		// Default
+		EUR:   2,
+		USD:   2,
+		"jpy": 0,
+		"XXX": 2,
+		"":    2,
+	}
+	for c, want := range tests {
+		assert.Equal(t, want, c.DecimalPlaces(), "%q.DecimalPlaces()", c)
+	}
+	for c, digits := range currencyDecimalDigits {
+		assert.Equal(t, digits, tests[c], "currency %s with %d decimal places covered by test", c, digits)
+	}
+}
+
+func TestCurrency_IsCrypto(t *testing.T) {
+	tests := map[Currency]bool{
+		BTC:    true,
+		"₿":    true,
+		ETH:    true,
+		"Ξ":    true,
+		USDT:   true,
+		"usdc": true,
+		EUR:    false,
+		USD:    false,
+		JPY:    false,
+		"":     false,
+		"XYZ":  false,
+	}
+	for currency, want := range tests {
+		assert.Equal(t, want, currency.IsCrypto(), "Currency(%q).IsCrypto()", currency)
+	}
+}
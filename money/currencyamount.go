@@ -2,7 +2,9 @@ package money
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/domonda/go-types/strutil"
@@ -19,9 +21,36 @@ func NewCurrencyAmount(currency Currency, amount Amount) CurrencyAmount {
 
 // ParseCurrencyAmount parses a currency and an amount from str with acceptedDecimals.
 // If acceptedDecimals is empty, then any decimal number is accepted.
+//
+// The currency can be a code or symbol before or after the amount
+// like "€1.234,56", "$1,234.56", "1234.56 USD", or "CHF 1'000.00".
+// A sign can be put before the currency like "-€5".
+// Both comma and dot decimal separators are detected.
+// An amount with a single separator and three digits after it
+// like "1.234" or "1,234" is ambiguous and parsed with
+// the separator as decimal separator, so as 1.234.
+// Use acceptedDecimals to reject such amounts.
 func ParseCurrencyAmount(str string, acceptedDecimals ...int) (result CurrencyAmount, err error) {
 	str = strutil.TrimSpace(str)
 
+	// Sign before currency like "-€5"
+	if len(str) > 1 && (str[0] == '-' || str[0] == '+') && strings.IndexAny(str[1:2], " .,'-+0123456789") == -1 {
+		result, err = ParseCurrencyAmount(str[1:], acceptedDecimals...)
+		if err != nil {
+			return CurrencyAmount{}, err
+		}
+		if result.Currency == "" {
+			return CurrencyAmount{}, fmt.Errorf("can't parse %q as currency amount", str)
+		}
+		if strings.ContainsAny(str[1:], "-+") {
+			return CurrencyAmount{}, fmt.Errorf("currency amount %q has multiple signs", str)
+		}
+		if str[0] == '-' {
+			result.Amount = -result.Amount
+		}
+		return result, nil
+	}
+
 	// Find first separator between currency and amount
 	if pos := strings.IndexAny(str, " .,'-+0123456789"); pos != -1 {
 		// Try parsing string until separator as currency
@@ -55,6 +84,42 @@ func (ca CurrencyAmount) String() string {
 	return ca.Format(true, 0, '.', 2)
 }
 
+// Neg returns the amount with negated sign
+// and the same currency.
+func (ca CurrencyAmount) Neg() CurrencyAmount {
+	return CurrencyAmount{Currency: ca.Currency, Amount: ca.Amount.Neg()}
+}
+
+// Abs returns the absolute amount
+// with the same currency.
+func (ca CurrencyAmount) Abs() CurrencyAmount {
+	return CurrencyAmount{Currency: ca.Currency, Amount: ca.Amount.Abs()}
+}
+
+// Percentage returns the amount multiplied by (percent / 100)
+// rounded to the decimal digits of the currency.
+func (ca CurrencyAmount) Percentage(percent float64) CurrencyAmount {
+	return CurrencyAmount{
+		Currency: ca.Currency,
+		Amount:   ca.Amount.Percentage(percent).RoundToDecimals(ca.Currency.DecimalDigits()),
+	}
+}
+
+// IsZero returns if the amount is zero.
+func (ca CurrencyAmount) IsZero() bool {
+	return ca.Amount.IsZero()
+}
+
+// IsNegative returns if the amount is smaller than zero.
+func (ca CurrencyAmount) IsNegative() bool {
+	return ca.Amount.IsNegative()
+}
+
+// IsPositive returns if the amount is greater than zero.
+func (ca CurrencyAmount) IsPositive() bool {
+	return ca.Amount.IsPositive()
+}
+
 func (ca CurrencyAmount) Format(currencyFirst bool, thousandsSep, decimalSep rune, precision int) string {
 	amountStr := ca.Amount.Format(thousandsSep, decimalSep, precision)
 	if ca.Currency == "" {
@@ -113,3 +178,64 @@ func (ca *CurrencyAmount) Scan(value any) (err error) {
 func (ca CurrencyAmount) Value() (driver.Value, error) {
 	return ca.String(), nil
 }
+
+// Object returns the CurrencyAmount as AmountObject
+// for marshalling as JSON object.
+func (ca CurrencyAmount) Object() AmountObject {
+	return AmountObject(ca)
+}
+
+// AmountObject is a CurrencyAmount that is marshalled
+// as JSON object like {"amount":"123.45","currency":"EUR"}
+// instead of the "EUR 123.45" string form of CurrencyAmount.String.
+// The amount is marshalled as string to avoid floating point precision loss.
+type AmountObject CurrencyAmount
+
+type amountObjectJSON struct {
+	Amount   json.RawMessage  `json:"amount"`
+	Currency NullableCurrency `json:"currency"`
+}
+
+// MarshalJSON implements encoding/json.Marshaler.
+// Amounts with whole cents are formatted with two decimals,
+// other amounts with the minimum number of decimals
+// needed to represent them exactly.
+// An empty currency is marshalled as null.
+func (o AmountObject) MarshalJSON() ([]byte, error) {
+	if !o.Amount.Valid() {
+		return nil, fmt.Errorf("can't marshal invalid amount %s as JSON", o.Amount.GoString())
+	}
+	amount := o.Amount.String()
+	if o.Amount != o.Amount.RoundToCents() {
+		amount = strconv.FormatFloat(float64(o.Amount), 'f', -1, 64)
+	}
+	return json.Marshal(amountObjectJSON{
+		Amount:   json.RawMessage(strconv.Quote(amount)),
+		Currency: NullableCurrency(o.Currency),
+	})
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler
+// and accepts the amount as JSON string or number.
+func (o *AmountObject) UnmarshalJSON(j []byte) error {
+	var obj amountObjectJSON
+	err := json.Unmarshal(j, &obj)
+	if err != nil {
+		return fmt.Errorf("can't unmarshal JSON(%s) as money.AmountObject because of: %w", j, err)
+	}
+	var amount Amount
+	if len(obj.Amount) > 0 {
+		err = amount.UnmarshalJSON(obj.Amount)
+		if err != nil {
+			return err
+		}
+	}
+	o.Amount = amount
+	o.Currency = Currency(obj.Currency)
+	return nil
+}
+
+// CurrencyAmount returns the AmountObject as CurrencyAmount.
+func (o AmountObject) CurrencyAmount() CurrencyAmount {
+	return CurrencyAmount(o)
+}
@@ -1,6 +1,7 @@
 package money
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -124,6 +125,21 @@ var currencyAmountTable = map[string]CurrencyAmount{
 	"1'234'567,89   EUR": {"EUR", 1234567.89},
 	"1'234'567,89   $":   {"USD", 1234567.89},
 	"$   1'234'567,89":   {"USD", 1234567.89},
+
+	"€1.234,56":     {"EUR", 1234.56},
+	"€ 1.234,56":    {"EUR", 1234.56},
+	"1.234,56 €":    {"EUR", 1234.56},
+	"$1,234.56":     {"USD", 1234.56},
+	"1234.56 USD":   {"USD", 1234.56},
+	"CHF 1'000.00":  {"CHF", 1000},
+	"£1,000,000.00": {"GBP", 1000000},
+	"1 234,56 eur":  {"EUR", 1234.56},
+	"€-5,00":        {"EUR", -5},
+	"-5,00 €":       {"EUR", -5},
+	"-€5,00":        {"EUR", -5},
+	"+€5,00":        {"EUR", 5},
+	"-EUR 1.234,56": {"EUR", -1234.56},
+	"-$1,234.56":    {"USD", -1234.56},
 }
 
 func TestParseCurrencyAmount(t *testing.T) {
@@ -152,3 +168,95 @@ func TestParseCurrencyAmount(t *testing.T) {
 		assert.Equal(t, expected, result, "ParseCurrencyAmount(%#v, %#v)", str, 2)
 	}
 }
+
+func TestParseCurrencyAmount_Ambiguous(t *testing.T) {
+	// A single separator is interpreted as decimal separator
+	for _, str := range []string{"1.234", "1,234", "€1.234", "1,234 USD"} {
+		result, err := ParseCurrencyAmount(str)
+		assert.NoError(t, err, "ParseCurrencyAmount(%#v)", str)
+		assert.Equal(t, Amount(1.234), result.Amount, "ParseCurrencyAmount(%#v)", str)
+		_, err = ParseCurrencyAmount(str, 2)
+		assert.Error(t, err, "ParseCurrencyAmount(%#v, 2)", str)
+	}
+
+	for _, str := range []string{"", "€", "-€", "-€-5", "-€+5", "--5 EUR", "- € 5"} {
+		_, err := ParseCurrencyAmount(str)
+		assert.Error(t, err, "ParseCurrencyAmount(%#v)", str)
+	}
+}
+
+func TestCurrencyAmount_StringRoundTrip(t *testing.T) {
+	for _, ca := range []CurrencyAmount{{"EUR", 123.45}, {"USD", -0.5}, {"", 1000}} {
+		parsed, err := ParseCurrencyAmount(ca.String())
+		assert.NoError(t, err, "ParseCurrencyAmount(%#v)", ca.String())
+		assert.Equal(t, ca, parsed)
+	}
+}
+
+func TestAmountObject_JSON(t *testing.T) {
+	tests := []struct {
+		obj  AmountObject
+		json string
+	}{
+		{obj: AmountObject{"EUR", 123.45}, json: `{"amount":"123.45","currency":"EUR"}`},
+		{obj: AmountObject{"USD", -1}, json: `{"amount":"-1.00","currency":"USD"}`},
+		{obj: AmountObject{"BTC", 0.00012345}, json: `{"amount":"0.00012345","currency":"BTC"}`},
+		{obj: AmountObject{"", 0.1}, json: `{"amount":"0.10","currency":null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			j, err := json.Marshal(tt.obj)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.json, string(j))
+
+			var parsed AmountObject
+			err = json.Unmarshal(j, &parsed)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.obj, parsed)
+		})
+	}
+
+	var parsed AmountObject
+	err := json.Unmarshal([]byte(`{"amount":99.9,"currency":"EUR"}`), &parsed)
+	assert.NoError(t, err, "amount as JSON number")
+	assert.Equal(t, CurrencyAmount{"EUR", 99.9}, parsed.CurrencyAmount())
+
+	err = json.Unmarshal([]byte(`{"amount":"abc","currency":"EUR"}`), &parsed)
+	assert.Error(t, err)
+}
+
+func TestCurrencyAmount_Sign(t *testing.T) {
+	tests := []struct {
+		ca         CurrencyAmount
+		neg        CurrencyAmount
+		abs        CurrencyAmount
+		isZero     bool
+		isNegative bool
+		isPositive bool
+	}{
+		{ca: CurrencyAmount{"EUR", 12.5}, neg: CurrencyAmount{"EUR", -12.5}, abs: CurrencyAmount{"EUR", 12.5}, isPositive: true},
+		{ca: CurrencyAmount{"USD", -3}, neg: CurrencyAmount{"USD", 3}, abs: CurrencyAmount{"USD", 3}, isNegative: true},
+		{ca: CurrencyAmount{"CHF", 0}, neg: CurrencyAmount{"CHF", 0}, abs: CurrencyAmount{"CHF", 0}, isZero: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ca.String(), func(t *testing.T) {
+			assert.Equal(t, tt.neg, tt.ca.Neg())
+			assert.Equal(t, tt.abs, tt.ca.Abs())
+			assert.Equal(t, tt.isZero, tt.ca.IsZero())
+			assert.Equal(t, tt.isNegative, tt.ca.IsNegative())
+			assert.Equal(t, tt.isPositive, tt.ca.IsPositive())
+
+			assert.Equal(t, tt.neg.Amount, tt.ca.Amount.Neg())
+			assert.Equal(t, tt.isZero, tt.ca.Amount.IsZero())
+			assert.Equal(t, tt.isNegative, tt.ca.Amount.IsNegative())
+			assert.Equal(t, tt.isPositive, tt.ca.Amount.IsPositive())
+		})
+	}
+}
+
+func TestCurrencyAmount_Percentage(t *testing.T) {
+	assert.Equal(t, CurrencyAmount{"EUR", 19}, CurrencyAmount{"EUR", 100}.Percentage(19))
+	assert.Equal(t, CurrencyAmount{"EUR", 22.61}, CurrencyAmount{"EUR", 119}.Percentage(19))
+	assert.Equal(t, CurrencyAmount{"JPY", 190}, CurrencyAmount{"JPY", 999}.Percentage(19))
+	assert.Equal(t, CurrencyAmount{"KWD", 0.19}, CurrencyAmount{"KWD", 0.999}.Percentage(19))
+}
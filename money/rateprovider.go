@@ -0,0 +1,66 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/domonda/go-types/date"
+)
+
+// RateProvider returns exchange rates between currencies.
+// The money package does not implement any external
+// rate source so that it stays decoupled from them,
+// instead a RateProvider is passed to Amount.Convert.
+type RateProvider interface {
+	// Rate returns the exchange rate to multiply an amount
+	// of the from currency with to get the amount in
+	// the to currency at the given date.
+	Rate(from, to Currency, date date.Date) (float64, error)
+}
+
+// RateKey is the key of a MapRateProvider
+type RateKey struct {
+	From Currency
+	To   Currency
+	Date date.Date
+}
+
+// MapRateProvider is a RateProvider with
+// fixed rates, useful for tests.
+type MapRateProvider map[RateKey]float64
+
+// Rate implements the RateProvider interface.
+// A rate of 1 is returned if from and to are the same currency.
+func (m MapRateProvider) Rate(from, to Currency, date date.Date) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+	rate, ok := m[RateKey{From: from, To: to, Date: date}]
+	if !ok {
+		return 0, fmt.Errorf("no exchange rate from %s to %s at %s", from, to, date)
+	}
+	return rate, nil
+}
+
+// Convert returns the amount in the from currency converted
+// to the to currency using the rate of rp at the given date.
+// The result is rounded to the decimal places of the to currency.
+// The amount is only rounded without calling rp
+// if from and to are the same currency.
+func (a Amount) Convert(from, to Currency, date date.Date, rp RateProvider) (Amount, error) {
+	from, err := from.Normalized()
+	if err != nil {
+		return 0, err
+	}
+	to, err = to.Normalized()
+	if err != nil {
+		return 0, err
+	}
+	if from == to {
+		return a.RoundToCurrency(to), nil
+	}
+	rate, err := rp.Rate(from, to, date)
+	if err != nil {
+		return 0, err
+	}
+	return Amount(float64(a) * rate).RoundToCurrency(to), nil
+}
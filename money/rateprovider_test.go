@@ -0,0 +1,52 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/domonda/go-types/date"
+)
+
+func TestAmount_Convert(t *testing.T) {
+	day := date.Date("2024-03-01")
+	rp := MapRateProvider{
+		{From: EUR, To: USD, Date: day}: 1.0832,
+		{From: USD, To: EUR, Date: day}: 0.9232,
+		{From: EUR, To: JPY, Date: day}: 162.38,
+		{From: EUR, To: KWD, Date: day}: 0.33316,
+	}
+
+	tests := []struct {
+		amount Amount
+		from   Currency
+		to     Currency
+		want   Amount
+	}{
+		{amount: 100, from: EUR, to: USD, want: 108.32},
+		{amount: 10.01, from: EUR, to: USD, want: 10.84}, // 10.842832
+		{amount: -10.01, from: EUR, to: USD, want: -10.84},
+		{amount: 108.32, from: USD, to: EUR, want: 100},  // 100.000... rounded
+		{amount: 12.34, from: EUR, to: JPY, want: 2004},  // 2003.7692
+		{amount: 12.34, from: EUR, to: KWD, want: 4.111}, // 4.11119
+		{amount: 0, from: EUR, to: USD, want: 0},
+		{amount: 12.345, from: EUR, to: EUR, want: 12.35},
+		{amount: 12.34, from: "eur", to: "€", want: 12.34},
+	}
+	for _, tt := range tests {
+		got, err := tt.amount.Convert(tt.from, tt.to, day, rp)
+		require.NoError(t, err, "%v %s to %s", tt.amount, tt.from, tt.to)
+		assert.Equal(t, tt.want, got, "%v %s to %s", tt.amount, tt.from, tt.to)
+	}
+
+	// Same currency does not need a rate
+	got, err := Amount(5).Convert(USD, USD, day, MapRateProvider{})
+	require.NoError(t, err)
+	assert.Equal(t, Amount(5), got)
+
+	_, err = Amount(1).Convert(EUR, USD, "2024-03-02", rp)
+	assert.Error(t, err, "no rate for date")
+	_, err = Amount(1).Convert(EUR, "XYZ", day, rp)
+	assert.Error(t, err, "invalid currency")
+}
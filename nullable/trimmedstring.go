@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 	"unsafe"
 
 	"github.com/domonda/go-types/strutil"
@@ -242,6 +243,9 @@ func (s TrimmedString) Value() (driver.Value, error) {
 }
 
 // Scan implements the database/sql.Scanner interface.
+// Leading and trailing whitespace of string and []byte
+// values is trimmed, so scanning " x " stores "x".
+// A nil value sets the string to null.
 func (s *TrimmedString) Scan(value any) error {
 	switch x := value.(type) {
 	case nil:
@@ -293,6 +297,9 @@ func (s TrimmedString) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements encoding/json.Unmarshaler.
+// Leading and trailing whitespace of JSON strings is trimmed
+// like with Scan and the JSON null value or a JSON string
+// consisting only of whitespace sets the string to null.
 func (s *TrimmedString) UnmarshalJSON(j []byte) error {
 	if bytes.Equal(j, []byte(`null`)) {
 		s.SetNull()
@@ -307,6 +314,23 @@ func (s *TrimmedString) UnmarshalJSON(j []byte) error {
 	return nil
 }
 
+// JSONSchema returns a JSON schema for the type
+// that allows a non empty string or null,
+// the JSON representations of TrimmedString.
+func (TrimmedString) JSONSchema() json.RawMessage {
+	return json.RawMessage(`{"oneOf":[{"type":"string","minLength":1},{"type":"null"}]}`)
+}
+
+// ValidateMaxLen returns an error if the trimmed string
+// has more than maxLen characters (Unicode code points).
+// A null string is always valid.
+func (s TrimmedString) ValidateMaxLen(maxLen int) error {
+	if l := utf8.RuneCountInString(s.String()); l > maxLen {
+		return fmt.Errorf("nullable.TrimmedString length %d exceeds maximum of %d", l, maxLen)
+	}
+	return nil
+}
+
 func (s TrimmedString) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	return e.EncodeElement(s.String(), start)
 }
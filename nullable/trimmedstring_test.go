@@ -82,3 +82,30 @@ func TestTrimmedString_String(t *testing.T) {
 		})
 	}
 }
+
+func TestTrimmedString_Scan(t *testing.T) {
+	var s TrimmedString
+	assert.NoError(t, s.Scan(" x "))
+	assert.Equal(t, TrimmedString("x"), s)
+
+	assert.NoError(t, s.Scan([]byte("\t y\n")))
+	assert.Equal(t, TrimmedString("y"), s)
+
+	assert.NoError(t, s.Scan(nil))
+	assert.True(t, s.IsNull())
+
+	assert.Error(t, s.Scan(1))
+}
+
+func TestTrimmedString_JSONSchema(t *testing.T) {
+	j, err := json.Marshal(TrimmedString("").JSONSchema())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"oneOf":[{"type":"string","minLength":1},{"type":"null"}]}`, string(j))
+}
+
+func TestTrimmedString_ValidateMaxLen(t *testing.T) {
+	assert.NoError(t, TrimmedString("").ValidateMaxLen(0))
+	assert.NoError(t, TrimmedString(" abc ").ValidateMaxLen(3))
+	assert.NoError(t, TrimmedString("äöü").ValidateMaxLen(3), "counts characters not bytes")
+	assert.Error(t, TrimmedString("abcd").ValidateMaxLen(3))
+}
@@ -0,0 +1,207 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// Type wraps a value of any type T that can be null.
+// The zero value of Type is null.
+// It implements the sql.Scanner and driver.Valuer interfaces
+// and also json.Marshaler and json.Unmarshaler.
+// The encoding.TextMarshaler and encoding.TextUnmarshaler
+// interfaces are implemented for T types that support them.
+type Type[T any] struct {
+	value T
+	valid bool
+}
+
+// TypeFrom returns a non null Type with the passed value.
+func TypeFrom[T any](value T) Type[T] {
+	return Type[T]{value: value, valid: true}
+}
+
+// TypeFromPtr returns a Type with the dereferenced
+// value of ptr or null if ptr is nil.
+func TypeFromPtr[T any](ptr *T) Type[T] {
+	if ptr == nil {
+		return Type[T]{}
+	}
+	return TypeFrom(*ptr)
+}
+
+// IsNull returns true if n is null.
+// IsNull implements the Nullable interface.
+func (n Type[T]) IsNull() bool {
+	return !n.valid
+}
+
+// IsNotNull returns true if n is not null.
+func (n Type[T]) IsNotNull() bool {
+	return n.valid
+}
+
+// IsZero returns true if n is null.
+// IsZero implements the Zeroable interface
+// and is used by encoding/json since Go 1.24
+// to omit null struct fields tagged with `json:",omitzero"`.
+// Note that `json:",omitempty"` has no effect
+// for struct types like Type.
+func (n Type[T]) IsZero() bool {
+	return !n.valid
+}
+
+// Get returns the non nullable value
+// or panics if n is null.
+// Note: check with IsNull before using Get!
+func (n Type[T]) Get() T {
+	if !n.valid {
+		panic(fmt.Sprintf("NULL nullable.Type[%T]", n.value))
+	}
+	return n.value
+}
+
+// GetOr returns the non nullable value
+// or the passed defaultValue if n is null.
+func (n Type[T]) GetOr(defaultValue T) T {
+	if !n.valid {
+		return defaultValue
+	}
+	return n.value
+}
+
+// Ptr returns a pointer to a copy of the value or nil if n is null.
+func (n Type[T]) Ptr() *T {
+	if !n.valid {
+		return nil
+	}
+	return &n.value
+}
+
+// Set a non null value.
+func (n *Type[T]) Set(value T) {
+	n.value = value
+	n.valid = true
+}
+
+// SetNull sets n to null.
+func (n *Type[T]) SetNull() {
+	var zero T
+	n.value = zero
+	n.valid = false
+}
+
+// String returns the value formatted with fmt.Sprint,
+// which uses the fmt.Stringer implementation of T if available,
+// or "NULL" if n is null.
+// String implements the fmt.Stringer interface.
+func (n Type[T]) String() string {
+	return n.StringOr("NULL")
+}
+
+// StringOr returns the value formatted with fmt.Sprint
+// or the passed nullStr if n is null.
+func (n Type[T]) StringOr(nullStr string) string {
+	if !n.valid {
+		return nullStr
+	}
+	return fmt.Sprint(n.value)
+}
+
+// Scan implements the database/sql.Scanner interface.
+// A nil value sets n to null.
+// If *T implements sql.Scanner, then scanning is delegated to it.
+func (n *Type[T]) Scan(value any) error {
+	if value == nil {
+		n.SetNull()
+		return nil
+	}
+	if scanner, ok := any(&n.value).(sql.Scanner); ok {
+		err := scanner.Scan(value)
+		if err != nil {
+			return err
+		}
+		n.valid = true
+		return nil
+	}
+	if v, ok := value.(T); ok {
+		n.Set(v)
+		return nil
+	}
+	return fmt.Errorf("can't scan %T as nullable.Type[%T]", value, n.value)
+}
+
+// Value implements the driver database/sql/driver.Valuer interface.
+// Returns nil for null.
+func (n Type[T]) Value() (driver.Value, error) {
+	if !n.valid {
+		return nil, nil
+	}
+	if valuer, ok := any(n.value).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.value)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler.
+// Interprets []byte(nil), []byte(""), []byte("null") as null.
+func (n *Type[T]) UnmarshalJSON(sourceJSON []byte) error {
+	if len(sourceJSON) == 0 || bytes.Equal(sourceJSON, []byte("null")) {
+		n.SetNull()
+		return nil
+	}
+	err := json.Unmarshal(sourceJSON, &n.value)
+	if err != nil {
+		return err
+	}
+	n.valid = true
+	return nil
+}
+
+// MarshalJSON implements encoding/json.Marshaler
+func (n Type[T]) MarshalJSON() ([]byte, error) {
+	if !n.valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+// by delegating to T if it implements encoding.TextMarshaler.
+// Returns empty text for null and an error if T
+// does not implement encoding.TextMarshaler.
+func (n Type[T]) MarshalText() ([]byte, error) {
+	if !n.valid {
+		return []byte{}, nil
+	}
+	marshaler, ok := any(n.value).(encoding.TextMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("nullable.Type[%T] can't be marshalled as text because %[1]T does not implement encoding.TextMarshaler", n.value)
+	}
+	return marshaler.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+// by delegating to *T if it implements encoding.TextUnmarshaler.
+// Empty text is interpreted as null.
+// Returns an error if *T does not implement encoding.TextUnmarshaler.
+func (n *Type[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+	unmarshaler, ok := any(&n.value).(encoding.TextUnmarshaler)
+	if !ok {
+		return fmt.Errorf("nullable.Type[%T] can't be unmarshalled from text because %[1]T does not implement encoding.TextUnmarshaler", n.value)
+	}
+	err := unmarshaler.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	n.valid = true
+	return nil
+}
@@ -0,0 +1,26 @@
+//go:build go1.24
+
+package nullable_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/domonda/go-types/nullable"
+)
+
+func TestType_OmitZero(t *testing.T) {
+	type S struct {
+		Int nullable.Type[int] `json:"int,omitzero"`
+	}
+
+	data, err := json.Marshal(S{})
+	require.NoError(t, err)
+	require.Equal(t, `{}`, string(data), "null field omitted")
+
+	data, err = json.Marshal(S{Int: nullable.TypeFrom(0)})
+	require.NoError(t, err)
+	require.Equal(t, `{"int":0}`, string(data), "zero but not null value present")
+}
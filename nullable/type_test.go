@@ -0,0 +1,98 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/domonda/go-types/date"
+	"github.com/domonda/go-types/nullable"
+)
+
+func TestType_String(t *testing.T) {
+	var null nullable.Type[date.Date]
+	require.Equal(t, "NULL", null.String())
+	require.Equal(t, "NULL", fmt.Sprint(null))
+	require.Equal(t, "-", null.StringOr("-"))
+
+	d := nullable.TypeFrom(date.Date("2024-02-29"))
+	require.Equal(t, "2024-02-29", d.String())
+	require.Equal(t, "2024-02-29", fmt.Sprint(d))
+
+	require.Equal(t, "0", nullable.TypeFrom(0).String())
+}
+
+func TestType_JSON(t *testing.T) {
+	var n nullable.Type[int]
+	j, err := json.Marshal(n)
+	require.NoError(t, err)
+	require.Equal(t, `null`, string(j))
+
+	n.Set(42)
+	j, err = json.Marshal(n)
+	require.NoError(t, err)
+	require.Equal(t, `42`, string(j))
+
+	var parsed nullable.Type[int]
+	require.NoError(t, json.Unmarshal([]byte(`7`), &parsed))
+	require.Equal(t, nullable.TypeFrom(7), parsed)
+	require.NoError(t, json.Unmarshal([]byte(`null`), &parsed))
+	require.True(t, parsed.IsNull())
+}
+
+func TestType_ScanValue(t *testing.T) {
+	var d nullable.Type[date.Date]
+	require.NoError(t, d.Scan("2024-01-02"))
+	require.Equal(t, date.Date("2024-01-02"), d.Get())
+	v, err := d.Value()
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-02", v)
+
+	require.NoError(t, d.Scan(nil))
+	require.True(t, d.IsNull())
+	v, err = d.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	var i nullable.Type[int64]
+	require.NoError(t, i.Scan(int64(5)))
+	require.Equal(t, int64(5), i.Get())
+	require.Error(t, i.Scan("5"))
+}
+
+func TestType_Text(t *testing.T) {
+	var addr nullable.Type[netip.Addr]
+	text, err := addr.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "", string(text), "null as empty text")
+
+	require.NoError(t, addr.UnmarshalText([]byte("192.168.0.1")))
+	require.Equal(t, nullable.TypeFrom(netip.MustParseAddr("192.168.0.1")), addr)
+	text, err = addr.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "192.168.0.1", string(text))
+
+	require.Error(t, addr.UnmarshalText([]byte("not an IP")))
+	require.NoError(t, addr.UnmarshalText(nil))
+	require.True(t, addr.IsNull(), "empty text as null")
+
+	i := nullable.TypeFrom(42)
+	_, err = i.MarshalText()
+	require.ErrorContains(t, err, "int does not implement encoding.TextMarshaler")
+	require.ErrorContains(t, i.UnmarshalText([]byte("7")), "int does not implement encoding.TextUnmarshaler")
+	require.NoError(t, i.UnmarshalText([]byte("")), "null without TextUnmarshaler")
+	require.True(t, i.IsNull())
+	text, err = i.MarshalText()
+	require.NoError(t, err, "null without TextMarshaler")
+	require.Empty(t, text)
+}
+
+func TestType_IsZero(t *testing.T) {
+	require.True(t, nullable.Type[int]{}.IsZero())
+	require.False(t, nullable.TypeFrom(0).IsZero())
+	require.True(t, nullable.ReflectIsNull(reflect.ValueOf(nullable.Type[int]{})))
+}
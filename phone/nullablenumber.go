@@ -0,0 +1,184 @@
+package phone
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/domonda/go-types/country"
+)
+
+// Null is an empty string and will be treatet as SQL NULL.
+var Null NullableNumber
+
+// NullableNumber is identical to Number, except that the Null value (empty string)
+// is considered valid by the Valid() and Validate() methods.
+type NullableNumber string
+
+// Normalized returns the number in E.164 format,
+// or an error if the number is not Null and not valid.
+func (n NullableNumber) Normalized() (NullableNumber, error) {
+	if n == Null {
+		return Null, nil
+	}
+	norm, err := Number(n).Normalized()
+	return NullableNumber(norm), err
+}
+
+// NormalizedOrNull returns n in E.164 format
+// or Null if n is not valid.
+func (n NullableNumber) NormalizedOrNull() NullableNumber {
+	normalized, err := n.Normalized()
+	if err != nil {
+		return Null
+	}
+	return normalized
+}
+
+// IsNull returns true if the NullableNumber is null.
+// IsNull implements the nullable.Nullable interface.
+func (n NullableNumber) IsNull() bool {
+	return n == Null
+}
+
+// IsNotNull returns true if the NullableNumber is not null.
+func (n NullableNumber) IsNotNull() bool {
+	return n != Null
+}
+
+// Set sets a Number for this NullableNumber
+func (n *NullableNumber) Set(number Number) {
+	*n = NullableNumber(number)
+}
+
+// SetNull sets the NullableNumber to null
+func (n *NullableNumber) SetNull() {
+	*n = Null
+}
+
+// Get returns the non nullable Number value
+// or panics if the NullableNumber is null.
+// Note: check with IsNull before using Get!
+func (n NullableNumber) Get() Number {
+	if n.IsNull() {
+		panic("NULL phone.Number")
+	}
+	return Number(n)
+}
+
+// GetOr returns the non nullable Number value
+// or the passed defaultNumber if the NullableNumber is null.
+func (n NullableNumber) GetOr(defaultNumber Number) Number {
+	if n.IsNull() {
+		return defaultNumber
+	}
+	return Number(n)
+}
+
+// StringOr returns the NullableNumber as string
+// or the passed nullString if the NullableNumber is null.
+func (n NullableNumber) StringOr(nullString string) string {
+	if n.IsNull() {
+		return nullString
+	}
+	return string(n)
+}
+
+// Valid returns if n is a valid phone number or Null,
+// ignoring normalization.
+func (n NullableNumber) Valid() bool {
+	return n.Validate() == nil
+}
+
+// ValidAndNotNull returns if n is a valid and not Null phone number.
+func (n NullableNumber) ValidAndNotNull() bool {
+	return n != Null && n.Valid()
+}
+
+// Validate returns an error if n is not a valid phone number or Null,
+// ignoring normalization.
+func (n NullableNumber) Validate() error {
+	if n == Null {
+		return nil
+	}
+	return Number(n).Validate()
+}
+
+// CountryCode returns the country.NullableCode of the number's calling code,
+// or country.Null if the number is null or not valid.
+// See Number.CountryCode.
+func (n NullableNumber) CountryCode() country.NullableCode {
+	if n.IsNull() {
+		return country.Null
+	}
+	return country.NullableCode(Number(n).CountryCode())
+}
+
+// String returns the normalized number if possible,
+// else it will be returned unchanged as string.
+// String implements the fmt.Stringer interface.
+func (n NullableNumber) String() string {
+	norm, err := n.Normalized()
+	if err != nil {
+		return string(n)
+	}
+	return string(norm)
+}
+
+// ScanString tries to parse and assign the passed
+// source string as value of the implementing type.
+//
+// If validate is true, the source string is checked
+// for validity before it is assigned to the type.
+//
+// If validate is false and the source string
+// can still be assigned in some non-normalized way
+// it will be assigned without returning an error.
+func (n *NullableNumber) ScanString(source string, validate bool) error {
+	switch source {
+	case "", "NULL", "null", "nil":
+		n.SetNull()
+		return nil
+	}
+	newNumber, err := NullableNumber(source).Normalized()
+	if err != nil {
+		if validate {
+			return err
+		}
+		newNumber = NullableNumber(source)
+	}
+	*n = newNumber
+	return nil
+}
+
+// Scan implements the database/sql.Scanner interface.
+func (n *NullableNumber) Scan(value any) error {
+	switch x := value.(type) {
+	case string:
+		*n = NullableNumber(x)
+	case []byte:
+		*n = NullableNumber(x)
+	case nil:
+		*n = Null
+	default:
+		return fmt.Errorf("can't scan SQL value of type %T as phone.NullableNumber", value)
+	}
+	return nil
+}
+
+// Value implements the driver database/sql/driver.Valuer interface.
+func (n NullableNumber) Value() (driver.Value, error) {
+	if n == Null {
+		return nil, nil
+	}
+	return Number(n).Value()
+}
+
+// MarshalJSON implements encoding/json.Marshaler
+// by returning the JSON null value for an empty (null) string.
+func (n NullableNumber) MarshalJSON() ([]byte, error) {
+	if n.IsNull() {
+		return []byte(`null`), nil
+	}
+	return json.Marshal(string(n))
+}
@@ -0,0 +1,274 @@
+package phone
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/domonda/go-types/country"
+	"github.com/domonda/go-types/strutil"
+)
+
+// Number is a phone number in E.164 format
+// like "+4915112345678".
+// Number implements the database/sql.Scanner and database/sql/driver.Valuer interfaces.
+// Use NullableNumber to read and write SQL NULL values.
+type Number string
+
+// nationalRule describes the national numbering plan
+// of a country calling code.
+type nationalRule struct {
+	// trunkPrefix is dialed before national numbers
+	// within the country and not part of E.164 numbers
+	trunkPrefix string
+	// minLen and maxLen are the allowed number of digits
+	// of the national significant number after the calling code
+	minLen, maxLen int
+	// firstDigits are the allowed first digits
+	// of the national significant number
+	firstDigits string
+}
+
+// nationalRules holds the numbering plans of the calling codes
+// supported for national numbers without calling code.
+// The calling code 1 is the North American Numbering Plan.
+var nationalRules = map[int]nationalRule{
+	1:  {trunkPrefix: "1", minLen: 10, maxLen: 10, firstDigits: "23456789"},
+	41: {trunkPrefix: "0", minLen: 9, maxLen: 9, firstDigits: "123456789"},
+	43: {trunkPrefix: "0", minLen: 4, maxLen: 13, firstDigits: "123456789"},
+	44: {trunkPrefix: "0", minLen: 9, maxLen: 10, firstDigits: "123456789"},
+	49: {trunkPrefix: "0", minLen: 6, maxLen: 13, firstDigits: "123456789"},
+}
+
+// primaryCountries holds the country returned by Number.CountryCode
+// for calling codes shared by multiple countries and territories.
+var primaryCountries = map[int]country.Code{
+	1:   country.US,
+	7:   country.RU,
+	39:  country.IT,
+	44:  country.GB,
+	47:  country.NO,
+	61:  country.AU,
+	64:  country.NZ,
+	212: country.MA,
+	262: country.RE,
+	358: country.FI,
+	500: country.FK,
+	590: country.GP,
+	599: country.CW,
+}
+
+// Normalize returns the raw phone number in E.164 format
+// like "+4915112345678".
+//
+// Spaces, dashes, dots, slashes, and parentheses are removed.
+// A "(0)" after the calling code like in "+49 (0)151 12345678"
+// is removed and numbers beginning with "00",
+// or "011" for the default country calling code 1,
+// are treated as international numbers.
+//
+// Numbers without calling code are interpreted as national
+// numbers of defaultCountry with the national trunk prefix
+// like the leading "0" in Germany removed.
+// National numbers are supported for the calling codes
+// of DE, AT, CH, GB, and the North American Numbering Plan
+// used by US and CA.
+//
+// The length and first digit of the national significant number
+// are validated for the countries supported for national numbers,
+// for other countries only the E.164 maximum length of 15 digits
+// and the calling code are validated.
+func Normalize(raw string, defaultCountry country.Code) (Number, error) {
+	s := strutil.TrimSpace(raw)
+	international := strings.HasPrefix(s, "+") || strings.HasPrefix(s, "00")
+	if international {
+		s = strings.Replace(s, "(0)", "", 1)
+	}
+	digits := make([]byte, 0, len(s))
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, byte(r))
+		case r == '+' && i == 0:
+		case strings.ContainsRune("-./()", r) || strutil.IsSpace(r):
+		default:
+			return "", fmt.Errorf("invalid character %q in phone number %q", r, raw)
+		}
+	}
+	number := string(digits)
+
+	defaultCallingCode, _ := defaultCountry.CallingCode()
+	switch {
+	case strings.HasPrefix(s, "+"):
+	case strings.HasPrefix(number, "00"):
+		number = number[2:]
+	case defaultCallingCode == 1 && strings.HasPrefix(number, "011"):
+		number = number[3:]
+	default:
+		rule, ok := nationalRules[defaultCallingCode]
+		if !ok {
+			return "", fmt.Errorf("phone number %q has no calling code and default country %q is not supported", raw, defaultCountry)
+		}
+		number = strconv.Itoa(defaultCallingCode) + strings.TrimPrefix(number, rule.trunkPrefix)
+	}
+
+	callingCode, national, ok := splitCallingCode(number)
+	if !ok {
+		return "", fmt.Errorf("phone number %q has an invalid calling code", raw)
+	}
+	if rule, ok := nationalRules[callingCode]; ok {
+		// Remove trunk prefix written after the calling code
+		// like in "+49 0151 12345678"
+		if rule.trunkPrefix == "0" {
+			national = strings.TrimPrefix(national, rule.trunkPrefix)
+		}
+		if len(national) < rule.minLen || len(national) > rule.maxLen {
+			return "", fmt.Errorf("phone number %q has an invalid length", raw)
+		}
+		if !strings.ContainsRune(rule.firstDigits, rune(national[0])) {
+			return "", fmt.Errorf("phone number %q has an invalid area code", raw)
+		}
+	} else if len(national) < 4 {
+		return "", fmt.Errorf("phone number %q is too short", raw)
+	}
+	number = strconv.Itoa(callingCode) + national
+	if len(number) > 15 {
+		return "", fmt.Errorf("phone number %q is too long", raw)
+	}
+	return Number("+" + number), nil
+}
+
+// splitCallingCode splits the digits of an international
+// phone number without leading plus into the calling code
+// and the national significant number.
+// Calling codes are prefix free, so no calling code
+// is the beginning of another calling code.
+func splitCallingCode(digits string) (callingCode int, national string, ok bool) {
+	if digits == "" || digits[0] == '0' {
+		return 0, "", false
+	}
+	for n := 1; n <= 3 && n < len(digits); n++ {
+		callingCode, _ = strconv.Atoi(digits[:n])
+		if len(country.CountriesForCallingCode(callingCode)) > 0 {
+			return callingCode, digits[n:], true
+		}
+	}
+	return 0, "", false
+}
+
+// Normalized returns the number in E.164 format
+// or an error if the number is not valid.
+// The number must include the calling code,
+// see Normalize for supported formats.
+func (n Number) Normalized() (Number, error) {
+	return Normalize(string(n), country.Invalid)
+}
+
+// Valid returns if n is a valid phone number
+// with calling code, ignoring normalization.
+func (n Number) Valid() bool {
+	_, err := n.Normalized()
+	return err == nil
+}
+
+// ValidAndNormalized returns if n is a valid phone number
+// in normalized E.164 format.
+func (n Number) ValidAndNormalized() bool {
+	norm, err := n.Normalized()
+	return err == nil && n == norm
+}
+
+// Validate returns an error if n is not a valid phone number
+// with calling code, ignoring normalization.
+func (n Number) Validate() error {
+	_, err := n.Normalized()
+	return err
+}
+
+// Nullable returns the number as NullableNumber
+func (n Number) Nullable() NullableNumber {
+	return NullableNumber(n)
+}
+
+// CallingCode returns the international calling code
+// of the number like 49 for "+4915112345678"
+// or zero if the number is not valid.
+func (n Number) CallingCode() int {
+	norm, err := n.Normalized()
+	if err != nil {
+		return 0
+	}
+	callingCode, _, _ := splitCallingCode(string(norm[1:]))
+	return callingCode
+}
+
+// CountryCode returns the country of the number's calling code
+// or country.Invalid if the number is not valid.
+// For calling codes shared by multiple countries
+// the main country is returned, like country.US for 1,
+// because the area codes are not evaluated.
+func (n Number) CountryCode() country.Code {
+	callingCode := n.CallingCode()
+	if code, ok := primaryCountries[callingCode]; ok {
+		return code
+	}
+	countries := country.CountriesForCallingCode(callingCode)
+	if len(countries) != 1 {
+		return country.Invalid
+	}
+	return countries[0]
+}
+
+// String returns the normalized number if possible,
+// else it will be returned unchanged as string.
+// String implements the fmt.Stringer interface.
+func (n Number) String() string {
+	norm, err := n.Normalized()
+	if err != nil {
+		return string(n)
+	}
+	return string(norm)
+}
+
+// ScanString tries to parse and assign the passed
+// source string as value of the implementing type.
+//
+// If validate is true, the source string is checked
+// for validity before it is assigned to the type.
+//
+// If validate is false and the source string
+// can still be assigned in some non-normalized way
+// it will be assigned without returning an error.
+func (n *Number) ScanString(source string, validate bool) error {
+	newNumber, err := Number(source).Normalized()
+	if err != nil {
+		if validate {
+			return err
+		}
+		newNumber = Number(source)
+	}
+	*n = newNumber
+	return nil
+}
+
+// Scan implements the database/sql.Scanner interface.
+func (n *Number) Scan(value any) error {
+	switch x := value.(type) {
+	case string:
+		*n = Number(x)
+	case []byte:
+		*n = Number(x)
+	case nil:
+		return errors.New("can't scan SQL NULL as phone.Number")
+	default:
+		return fmt.Errorf("can't scan SQL value of type %T as phone.Number", value)
+	}
+	return nil
+}
+
+// Value implements the driver database/sql/driver.Valuer interface.
+func (n Number) Value() (driver.Value, error) {
+	return n.String(), nil
+}
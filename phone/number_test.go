@@ -0,0 +1,153 @@
+package phone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/domonda/go-types/country"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		raw            string
+		defaultCountry country.Code
+		want           Number
+	}{
+		// Messy German inputs for the same number
+		{raw: "+4915112345678", defaultCountry: country.DE, want: "+4915112345678"},
+		{raw: "0151 12345678", defaultCountry: country.DE, want: "+4915112345678"},
+		{raw: "0151-123 456 78", defaultCountry: country.DE, want: "+4915112345678"},
+		{raw: "(0151) 12345678", defaultCountry: country.DE, want: "+4915112345678"},
+		{raw: "0151/12345678", defaultCountry: country.DE, want: "+4915112345678"},
+		{raw: "+49 151 12345678", defaultCountry: country.DE, want: "+4915112345678"},
+		{raw: "+49 (0)151 12345678", defaultCountry: country.DE, want: "+4915112345678"},
+		{raw: "+49 0151 12345678", defaultCountry: country.DE, want: "+4915112345678"},
+		{raw: "0049 151 12345678", defaultCountry: country.DE, want: "+4915112345678"},
+		{raw: " 0049-151-12345678 ", defaultCountry: country.AT, want: "+4915112345678"},
+		{raw: "+49 151 12345678", defaultCountry: country.Invalid, want: "+4915112345678"},
+		// Other countries
+		{raw: "030 1234567", defaultCountry: country.DE, want: "+49301234567"},
+		{raw: "01 5880 1234", defaultCountry: country.AT, want: "+43158801234"},
+		{raw: "044 668 18 00", defaultCountry: country.CH, want: "+41446681800"},
+		{raw: "020 7946 0018", defaultCountry: country.GB, want: "+442079460018"},
+		{raw: "(212) 555-1234", defaultCountry: country.US, want: "+12125551234"},
+		{raw: "1-212-555-1234", defaultCountry: country.US, want: "+12125551234"},
+		{raw: "011 49 151 12345678", defaultCountry: country.US, want: "+4915112345678"},
+		{raw: "416.555.1234", defaultCountry: country.CA, want: "+14165551234"},
+		{raw: "+33 6 12 34 56 78", defaultCountry: country.DE, want: "+33612345678"},
+		{raw: "+39 06 1234 5678", defaultCountry: country.DE, want: "+390612345678"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := Normalize(tt.raw, tt.defaultCountry)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalize_Invalid(t *testing.T) {
+	tests := []struct {
+		raw            string
+		defaultCountry country.Code
+	}{
+		{raw: "", defaultCountry: country.DE},
+		{raw: "0151 12345678", defaultCountry: country.Invalid},
+		{raw: "06 12 34 56 78", defaultCountry: country.FR},
+		{raw: "0151 1234567A", defaultCountry: country.DE},
+		{raw: "0151 1234 5678 9012", defaultCountry: country.DE},
+		{raw: "030 12", defaultCountry: country.DE},
+		{raw: "044 668 18 0", defaultCountry: country.CH},
+		{raw: "(012) 555-1234", defaultCountry: country.US},
+		{raw: "+0 123 456789", defaultCountry: country.DE},
+		{raw: "+1234567890123456", defaultCountry: country.DE},
+		{raw: "+49", defaultCountry: country.DE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			_, err := Normalize(tt.raw, tt.defaultCountry)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNumber_CountryCode(t *testing.T) {
+	tests := []struct {
+		number Number
+		want   country.Code
+	}{
+		{number: "+4915112345678", want: country.DE},
+		{number: "+43 1 58801234", want: country.AT},
+		{number: "+41446681800", want: country.CH},
+		{number: "+442079460018", want: country.GB},
+		{number: "+12125551234", want: country.US},
+		{number: "+33612345678", want: country.FR},
+		{number: "015112345678", want: country.Invalid},
+		{number: "", want: country.Invalid},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.number), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.number.CountryCode())
+		})
+	}
+	assert.Equal(t, 49, Number("+49 151 12345678").CallingCode())
+	assert.Equal(t, 0, Number("invalid").CallingCode())
+}
+
+func TestNumber_Validate(t *testing.T) {
+	assert.NoError(t, Number("+4915112345678").Validate())
+	assert.NoError(t, Number("+49 151 12345678").Validate())
+	assert.True(t, Number("+4915112345678").ValidAndNormalized())
+	assert.False(t, Number("+49 151 12345678").ValidAndNormalized())
+	assert.Error(t, Number("015112345678").Validate(), "national number without default country")
+	assert.Error(t, Number("").Validate())
+}
+
+func TestNumber_SQL(t *testing.T) {
+	var n Number
+	require.NoError(t, n.Scan("+4915112345678"))
+	assert.Equal(t, Number("+4915112345678"), n)
+	require.NoError(t, n.Scan([]byte("+442079460018")))
+	assert.Equal(t, Number("+442079460018"), n)
+	assert.Error(t, n.Scan(nil))
+	assert.Error(t, n.Scan(123))
+
+	value, err := Number("+49 151 12345678").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "+4915112345678", value)
+
+	var nn NullableNumber
+	require.NoError(t, nn.Scan(nil))
+	assert.Equal(t, Null, nn)
+	value, err = nn.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	require.NoError(t, nn.Scan("+4915112345678"))
+	value, err = nn.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "+4915112345678", value)
+}
+
+func TestNullableNumber(t *testing.T) {
+	assert.True(t, Null.Valid())
+	assert.False(t, Null.ValidAndNotNull())
+	assert.True(t, NullableNumber("+4915112345678").ValidAndNotNull())
+	assert.False(t, NullableNumber("0151").Valid())
+	assert.Equal(t, country.Null, Null.CountryCode())
+	assert.Equal(t, country.NullableCode(country.DE), NullableNumber("+4915112345678").CountryCode())
+
+	var n NullableNumber
+	require.NoError(t, n.ScanString("+49 (0)151 12345678", true))
+	assert.Equal(t, NullableNumber("+4915112345678"), n)
+	require.NoError(t, n.ScanString("null", true))
+	assert.Equal(t, Null, n)
+	assert.Error(t, n.ScanString("0151 12345678", true))
+	require.NoError(t, n.ScanString("0151 12345678", false))
+	assert.Equal(t, NullableNumber("0151 12345678"), n)
+
+	data, err := Null.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
@@ -97,6 +97,37 @@ func Join[T ~string](elems []T, sep string) string {
 	return b.String()
 }
 
+// SplitAndTrim splits s at every sep, trims whitespace
+// from the resulting parts and drops empty parts,
+// so leading, trailing, and consecutive separators
+// don't produce empty elements.
+// Returns nil if s contains no non-empty parts.
+func SplitAndTrim(s, sep string) []string {
+	var parts []string
+	for _, part := range strings.Split(s, sep) {
+		if part = TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+// JoinNonEmpty concatenates the non-empty parts
+// with sep between them.
+func JoinNonEmpty(parts []string, sep string) string {
+	var b strings.Builder
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
 // CompareStringsShorterFirst compares two strings by length first and then by lexicographical order.
 func CompareStringsShorterFirst[T ~string](a, b T) int {
 	if len(a) < len(b) {
@@ -121,3 +152,31 @@ func CompareStringsShorterFirst[T ~string](a, b T) int {
 func ConvertSlice[T, S ~string](s []S) []T {
 	return *(*[]T)(unsafe.Pointer(&s))
 }
+
+// LevenshteinDistance returns the minimum number of
+// single rune insertions, deletions, or substitutions
+// needed to change a into b.
+func LevenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+	// Only two rows of the distance matrix are needed
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := range ra {
+		curr[0] = i + 1
+		for j := range rb {
+			cost := 1
+			if ra[i] == rb[j] {
+				cost = 0
+			}
+			curr[j+1] = min(prev[j+1]+1, curr[j]+1, prev[j]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -15,3 +15,64 @@ func TestConvertSlice(t *testing.T) {
 	out = ConvertSlice[StringType]([]string(nil))
 	require.Nil(t, out)
 }
+
+func TestLevenshteinDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "", b: "", want: 0},
+		{a: "abc", b: "", want: 3},
+		{a: "", b: "abc", want: 3},
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "deutschand", b: "deutschland", want: 1},
+		{a: "österreich", b: "osterreich", want: 1},
+		{a: "same", b: "same", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			require.Equal(t, tt.want, LevenshteinDistance(tt.a, tt.b))
+			require.Equal(t, tt.want, LevenshteinDistance(tt.b, tt.a), "symmetric")
+		})
+	}
+}
+
+func TestSplitAndTrim(t *testing.T) {
+	tests := []struct {
+		s    string
+		sep  string
+		want []string
+	}{
+		{s: "", sep: ",", want: nil},
+		{s: " , ,", sep: ",", want: nil},
+		{s: "a", sep: ",", want: []string{"a"}},
+		{s: "a,b,c", sep: ",", want: []string{"a", "b", "c"}},
+		{s: " a , b\t,\nc ", sep: ",", want: []string{"a", "b", "c"}},
+		{s: ",a,b,", sep: ",", want: []string{"a", "b"}},
+		{s: "a,,,b", sep: ",", want: []string{"a", "b"}},
+		{s: "1 - 12", sep: "-", want: []string{"1", "12"}},
+		{s: "a::b::", sep: "::", want: []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			require.Equal(t, tt.want, SplitAndTrim(tt.s, tt.sep))
+		})
+	}
+}
+
+func TestJoinNonEmpty(t *testing.T) {
+	tests := []struct {
+		parts []string
+		sep   string
+		want  string
+	}{
+		{parts: nil, sep: ", ", want: ""},
+		{parts: []string{"", ""}, sep: ", ", want: ""},
+		{parts: []string{"a"}, sep: ", ", want: "a"},
+		{parts: []string{"a", "b"}, sep: ", ", want: "a, b"},
+		{parts: []string{"", "a", "", "", "b", ""}, sep: ", ", want: "a, b"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, JoinNonEmpty(tt.parts, tt.sep), "%q", tt.parts)
+	}
+}
@@ -0,0 +1,33 @@
+package uu
+
+// IDGenerator is an interface for generating new IDs.
+//
+// Services can accept an IDGenerator to be able to
+// swap random ID generation with a deterministic
+// implementation for testing.
+type IDGenerator interface {
+	NewID() ID
+}
+
+// IDGeneratorFunc implements IDGenerator with a function.
+type IDGeneratorFunc func() ID
+
+// NewID implements IDGenerator by calling f.
+func (f IDGeneratorFunc) NewID() ID {
+	return f()
+}
+
+// DefaultIDGenerator is an IDGenerator using IDv7.
+var DefaultIDGenerator IDGenerator = IDGeneratorFunc(IDv7)
+
+// DeterministicIDGenerator returns an IDGenerator
+// returning deterministic version 7 UUIDs starting
+// at the passed Unix Epoch in milliseconds and counting
+// up from there for every generated ID.
+//
+// The returned IDGenerator is safe for concurrent use.
+//
+// See IDv7DeterministicFunc.
+func DeterministicIDGenerator(startAtUnixMilli int64) IDGenerator {
+	return IDGeneratorFunc(IDv7DeterministicFunc(startAtUnixMilli))
+}
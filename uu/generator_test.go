@@ -0,0 +1,25 @@
+package uu
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeterministicIDGenerator(t *testing.T) {
+	gen := DeterministicIDGenerator(1000)
+	require.Equal(t, IDv7Deterministic(1000), gen.NewID())
+	require.Equal(t, IDv7Deterministic(1001), gen.NewID())
+	require.Equal(t, IDv7Deterministic(1002), gen.NewID())
+
+	// A new generator repeats the same sequence
+	gen = DeterministicIDGenerator(1000)
+	require.Equal(t, IDv7Deterministic(1000), gen.NewID())
+}
+
+func TestDefaultIDGenerator(t *testing.T) {
+	id := DefaultIDGenerator.NewID()
+	require.NoError(t, id.Validate(), "validating UUID")
+	require.Equal(t, uint(7), id.Version(), "detecting version 7")
+	require.NotEqual(t, id, DefaultIDGenerator.NewID())
+}
@@ -8,13 +8,13 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"hash"
 	"io"
 	"strings"
 	"sync"
 	"time"
-	"unsafe"
 )
 
 // The nil UUID is special form of UUID that is specified to have all
@@ -92,12 +92,33 @@ func IDv5(ns ID, name string) ID {
 	return id
 }
 
+// NamespaceFor returns a stable namespace ID for the passed
+// name like "com.example.invoices" by using IDv5 with NamespaceOID.
+// The result can be used as namespace for IDv5 to create
+// application specific IDs that don't collide with IDs
+// of other namespaces for the same name.
+func NamespaceFor(name string) ID {
+	return IDv5(NamespaceOID, name)
+}
+
+// IDForURL returns the version 5 ID of the passed URL
+// in the namespace NamespaceURL.
+func IDForURL(url string) ID {
+	return IDv5(NamespaceURL, url)
+}
+
+// IDForDNS returns the version 5 ID of the passed
+// fully qualified domain name in the namespace NamespaceDNS.
+func IDForDNS(host string) ID {
+	return IDv5(NamespaceDNS, host)
+}
+
 // IDv7 returns a version 7 ID with the first 48 bits
-// containing a sortable timestamp and random
+// containing a sortable big-endian timestamp and random
 // data after the version and variant information.
 func IDv7() ID {
 	var id ID
-	*(*int64)(unsafe.Pointer(&id[0])) = time.Now().UnixMilli() //#nosec G103 -- unsafe OK
+	putUnixMilli48(&id, time.Now().UnixMilli())
 	safeRandom(id[6:])
 	id.SetVersion(7)
 	id.SetVariant()
@@ -112,12 +133,21 @@ func IDv7() ID {
 // see also IDv7DeterministicFunc.
 func IDv7Deterministic(unixMilli int64) ID {
 	var id ID
-	*(*int64)(unsafe.Pointer(&id[0])) = unixMilli //#nosec G103 -- unsafe OK
+	putUnixMilli48(&id, unixMilli)
 	id.SetVersion(7)
 	id.SetVariant()
 	return id
 }
 
+// putUnixMilli48 writes the lower 48 bits of unixMilli
+// as big-endian to the first 6 bytes of id
+// as required for version 7 by RFC 9562.
+func putUnixMilli48(id *ID, unixMilli int64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(unixMilli)) //#nosec G115 -- only the lower 48 bits are used
+	copy(id[:6], b[2:])
+}
+
 // IDv7DeterministicFunc returns a function that generates
 // deterministic version 7 UUIDs starting at the passed Unix Epoch
 // in milliseconds and counting up from there for every
@@ -447,6 +477,66 @@ func (id ID) GoString() string {
 	return "uu.IDFrom(`" + id.String() + "`)"
 }
 
+// DebugString returns the canonical string representation
+// of the UUID annotated with its version, variant,
+// and the decoded timestamp for time based versions:
+//
+//	6ba7b810-9dad-11d1-80b4-00c04fd430c8 (v1, RFC4122, 1998-02-04T22:13:53.1511824Z)
+func (id ID) DebugString() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (v%d, %s", id, id.Version(), variantName(id.Variant()))
+	if t, ok := id.Time(); ok {
+		b.WriteString(", ")
+		b.WriteString(t.UTC().Format(time.RFC3339Nano))
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+func variantName(variant uint) string {
+	switch variant {
+	case IDVariantNCS:
+		return "NCS"
+	case IDVariantRFC4122:
+		return "RFC4122"
+	case IDVariantMicrosoft:
+		return "Microsoft"
+	}
+	return "Invalid"
+}
+
+// Time returns the timestamp of time based
+// version 1, 6, and 7 IDs or false for other versions.
+// Version 1 and 6 timestamps have a precision of 100 nanoseconds,
+// version 7 timestamps a precision of milliseconds
+// and are decoded in the byte order used by IDv7 and IDv7Deterministic.
+func (id ID) Time() (time.Time, bool) {
+	switch id.Version() {
+	case 1:
+		ts := uint64(binary.BigEndian.Uint32(id[0:])) |
+			uint64(binary.BigEndian.Uint16(id[4:]))<<32 |
+			uint64(binary.BigEndian.Uint16(id[6:])&0x0fff)<<48
+		return gregorianToTime(ts), true
+	case 6:
+		ts := uint64(binary.BigEndian.Uint32(id[0:]))<<28 |
+			uint64(binary.BigEndian.Uint16(id[4:]))<<12 |
+			uint64(binary.BigEndian.Uint16(id[6:])&0x0fff)
+		return gregorianToTime(ts), true
+	case 7:
+		var ms [8]byte
+		copy(ms[2:], id[:6])
+		return time.UnixMilli(int64(binary.BigEndian.Uint64(ms[:]))), true //#nosec G115 -- 48 bits fit into int64
+	}
+	return time.Time{}, false
+}
+
+// gregorianToTime converts 100-nanosecond intervals
+// since the UUID epoch (October 15, 1582) to a time.Time.
+func gregorianToTime(ts uint64) time.Time {
+	unix100ns := int64(ts) - epochStart //#nosec G115 -- 60 bits fit into int64
+	return time.Unix(unix100ns/1e7, (unix100ns%1e7)*100)
+}
+
 // PrettyPrint the ID in the format xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.
 // Implements the pretty.Printable interface.
 func (id ID) PrettyPrint(w io.Writer) {
@@ -501,6 +591,39 @@ func (id *ID) UnmarshalText(text []byte) (err error) {
 	return nil
 }
 
+// MarshalJSON implements the encoding/json.Marshaler interface
+// by returning the dashed string returned by String
+// as JSON string.
+func (id ID) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 1, 38)
+	b[0] = '"'
+	b = append(b, id.StringBytes()...)
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+// The JSON string can be in any format supported by UnmarshalText,
+// including the 22 character base64 and the 32 character hex format.
+// JSON null is ignored and leaves the ID unchanged
+// like encoding/json does for non pointer types.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return fmt.Errorf("can't unmarshal JSON %s as uu.ID: %w", data, err)
+	}
+	newID, err := IDFromString(s)
+	if err != nil {
+		return err
+	}
+	*id = newID
+	return nil
+}
+
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
 func (id ID) MarshalBinary() (data []byte, err error) {
 	return id[:], nil
@@ -525,6 +648,12 @@ func (id ID) Value() (driver.Value, error) {
 // Scan implements the sql.Scanner interface.
 // A 16-byte slice is handled by UnmarshalBinary, while
 // a longer byte slice or a string is handled by UnmarshalText.
+// A [16]byte array as used by pgx/pgtype is scanned as binary UUID.
+//
+// Note that a 16 byte slice is always interpreted as binary UUID,
+// even if it consists of ASCII characters that could be text.
+// Use ScanText or ScanBinary if the format is known
+// to avoid this ambiguity.
 func (id *ID) Scan(src any) error {
 	switch src := src.(type) {
 	case []byte:
@@ -535,11 +664,44 @@ func (id *ID) Scan(src any) error {
 
 	case string:
 		return id.UnmarshalText([]byte(src))
+
+	case [16]byte:
+		*id = src
+		return nil
 	}
 
 	return fmt.Errorf("cannot convert %T to uu.ID", src)
 }
 
+// ScanText scans a string or byte slice
+// that is always interpreted as text representation
+// of the UUID using UnmarshalText.
+func (id *ID) ScanText(src any) error {
+	switch src := src.(type) {
+	case []byte:
+		return id.UnmarshalText(src)
+	case string:
+		return id.UnmarshalText([]byte(src))
+	}
+	return fmt.Errorf("cannot convert %T as text to uu.ID", src)
+}
+
+// ScanBinary scans a 16 byte slice, string, or array
+// that is always interpreted as binary UUID
+// using UnmarshalBinary.
+func (id *ID) ScanBinary(src any) error {
+	switch src := src.(type) {
+	case []byte:
+		return id.UnmarshalBinary(src)
+	case string:
+		return id.UnmarshalBinary([]byte(src))
+	case [16]byte:
+		*id = src
+		return nil
+	}
+	return fmt.Errorf("cannot convert %T as binary to uu.ID", src)
+}
+
 // Returns UUID v1/v2 storage state.
 // Returns epoch timestamp, clock sequence, and hardware address.
 func getStorage() (uint64, uint16, []byte) {
@@ -567,12 +729,20 @@ func idFromHash(h hash.Hash, ns ID, name string) (id ID) {
 	return id
 }
 
-// Less returns true if the 128 bit unsigned integer value
-// of the id is less than the passed rhs.
+// Less returns true if the 128 bit unsigned big-endian
+// integer value of the id is less than the passed rhs,
+// which is the same order as Compare.
 func (id ID) Less(rhs ID) bool {
-	l := (*[2]uint64)(unsafe.Pointer(&id[0]))  //#nosec G103 -- unsafe OK
-	r := (*[2]uint64)(unsafe.Pointer(&rhs[0])) //#nosec G103 -- unsafe OK
-	return l[1] < r[1] || (l[1] == r[1] && l[0] < r[0])
+	return id.Compare(rhs) < 0
+}
+
+// Compare returns -1 if the bytes of the id are less
+// than the bytes of the passed other, +1 if they are greater,
+// or 0 if both are equal.
+// This is the order of the ID strings
+// and of the PostgreSQL uuid type.
+func (id ID) Compare(other ID) int {
+	return bytes.Compare(id[:], other[:])
 }
 
 func parseDashedFormat(text, original []byte) (newID ID, err error) {
@@ -2,8 +2,11 @@ package uu
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -366,6 +369,43 @@ func TestUnmarshalText(t *testing.T) {
 	}
 }
 
+func TestIDJSON(t *testing.T) {
+	u := ID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	b, err := json.Marshal(u)
+	require.NoError(t, err)
+	require.Equal(t, `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`, string(b))
+
+	for _, data := range []string{
+		`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+		`"a6e4EJ2tEdGAtADAT9QwyA"`,
+		`"6ba7b8109dad11d180b400c04fd430c8"`,
+		`"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"`,
+		`"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+		`"\u0036ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+	} {
+		var parsed ID
+		err := json.Unmarshal([]byte(data), &parsed)
+		require.NoError(t, err, data)
+		require.Equal(t, u, parsed, data)
+	}
+
+	parsed := u
+	require.NoError(t, json.Unmarshal([]byte(`null`), &parsed))
+	require.Equal(t, u, parsed, "null leaves ID unchanged")
+
+	for _, data := range []string{`""`, `"invalid"`, `123`, `{}`} {
+		require.Error(t, json.Unmarshal([]byte(data), &parsed), data)
+	}
+
+	type wrapper struct {
+		ID ID `json:"id"`
+	}
+	var w wrapper
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"a6e4EJ2tEdGAtADAT9QwyA"}`), &w))
+	require.Equal(t, u, w.ID)
+}
+
 func TestValue(t *testing.T) {
 	u, err := IDFromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
 	if err != nil {
@@ -464,6 +504,67 @@ func TestScanText(t *testing.T) {
 	}
 }
 
+func TestScanArray(t *testing.T) {
+	u := ID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	u1 := ID{}
+	err := u1.Scan([16]byte(u))
+	if err != nil {
+		t.Errorf("Error scanning [16]byte: %s", err)
+	}
+	if u != u1 {
+		t.Errorf("UUIDs should be equal: %s and %s", u, u1)
+	}
+}
+
+func TestID_ScanText(t *testing.T) {
+	u := ID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	for _, src := range []any{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", []byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8")} {
+		u1 := ID{}
+		err := u1.ScanText(src)
+		if err != nil {
+			t.Errorf("Error scanning %T as text: %s", src, err)
+		}
+		if u != u1 {
+			t.Errorf("UUIDs should be equal: %s and %s", u, u1)
+		}
+	}
+
+	// 16 ASCII characters are binary for Scan but an error for ScanText
+	u2 := ID{}
+	err := u2.ScanText([]byte("0123456789abcdef"))
+	if err == nil {
+		t.Errorf("Should return error scanning 16 bytes as text")
+	}
+
+	err = u2.ScanText(u.Bytes())
+	if err == nil {
+		t.Errorf("Should return error scanning binary UUID as text")
+	}
+}
+
+func TestID_ScanBinary(t *testing.T) {
+	u := ID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	for _, src := range []any{u.Bytes(), string(u.Bytes()), [16]byte(u)} {
+		u1 := ID{}
+		err := u1.ScanBinary(src)
+		if err != nil {
+			t.Errorf("Error scanning %T as binary: %s", src, err)
+		}
+		if u != u1 {
+			t.Errorf("UUIDs should be equal: %s and %s", u, u1)
+		}
+	}
+
+	u2 := ID{}
+	err := u2.ScanBinary([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+	if err == nil {
+		t.Errorf("Should return error scanning 36 bytes as binary")
+	}
+}
+
 func TestScanUnsupported(t *testing.T) {
 	u := ID{}
 
@@ -573,6 +674,48 @@ func TestIDv3(t *testing.T) {
 	}
 }
 
+func TestNamespaceFor(t *testing.T) {
+	ns := NamespaceFor("com.example.invoices")
+	if ns.String() != "db20303f-8738-5b61-8d41-9ee721c7d4c1" {
+		t.Errorf("NamespaceFor generated incorrectly: %s", ns)
+	}
+	if NamespaceFor("com.example.invoices") != ns {
+		t.Errorf("NamespaceFor generated different IDs for the same name")
+	}
+	if NamespaceFor("com.example.orders") == ns {
+		t.Errorf("NamespaceFor generated the same ID for different names")
+	}
+	if IDv5(ns, "1") == IDv5(NamespaceFor("com.example.orders"), "1") {
+		t.Errorf("IDv5 generated the same ID for the same name in different sub-namespaces")
+	}
+}
+
+func TestIDForURL(t *testing.T) {
+	u := IDForURL("https://www.python.org/")
+	if u.String() != "5406f80d-92e9-51cd-a176-77445955e733" {
+		t.Errorf("IDForURL generated incorrectly: %s", u)
+	}
+	if IDForURL("https://www.python.org/") != u {
+		t.Errorf("IDForURL generated different IDs for the same URL")
+	}
+	if IDForDNS("https://www.python.org/") == u {
+		t.Errorf("IDForDNS and IDForURL generated the same ID for the same name")
+	}
+}
+
+func TestIDForDNS(t *testing.T) {
+	u := IDForDNS("python.org")
+	if u.String() != "886313e1-3b8a-5372-9b90-0c9aee199e5d" {
+		t.Errorf("IDForDNS generated incorrectly: %s", u)
+	}
+	if IDForDNS("python.org") != u {
+		t.Errorf("IDForDNS generated different IDs for the same host")
+	}
+	if IDForURL("python.org") == u || NamespaceFor("python.org") == u {
+		t.Errorf("different namespaces generated the same ID for the same name")
+	}
+}
+
 func TestIDv4(t *testing.T) {
 	u := IDv4()
 
@@ -645,6 +788,11 @@ func TestIDv7DeterministicFunc(t *testing.T) {
 	id = IDv7Deterministic(time.Now().UnixMilli())
 	require.NoError(t, id.Validate(), "validating UUID")
 	require.Equal(t, uint(7), id.Version(), "detecting version 7")
+
+	// Timestamp is big-endian as required by RFC 9562
+	id = IDv7Deterministic(time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC).UnixMilli())
+	require.Equal(t, "017f22e2-79b0-7000-8000-000000000000", id.String())
+	require.Less(t, IDv7Deterministic(255).String(), IDv7Deterministic(256).String(), "byte order sorts by time")
 }
 
 func TestID_GoString(t *testing.T) {
@@ -673,6 +821,66 @@ func TestID_GoString(t *testing.T) {
 
 }
 
+func TestID_DebugString(t *testing.T) {
+	v7 := IDv7Deterministic(time.Date(2024, 3, 1, 12, 0, 0, 123e6, time.UTC).UnixMilli())
+	tests := []struct {
+		name string
+		id   ID
+		want string
+	}{
+		{"v1", NamespaceDNS, "6ba7b810-9dad-11d1-80b4-00c04fd430c8 (v1, RFC4122, 1998-02-04T22:13:53.1511824Z)"},
+		{"v4", IDMustFromString("9256978d-18e6-4435-ad16-d7046d41b71a"), "9256978d-18e6-4435-ad16-d7046d41b71a (v4, RFC4122)"},
+		{"v7", v7, "018df9e2-b27b-7000-8000-000000000000 (v7, RFC4122, 2024-03-01T12:00:00.123Z)"},
+		{"v7 RFC 9562", IDMustFromString("017f22e2-79b0-7cc3-98c4-dc0c0c07398f"), "017f22e2-79b0-7cc3-98c4-dc0c0c07398f (v7, RFC4122, 2022-02-22T19:22:22Z)"},
+		{"nil", IDNil, "00000000-0000-0000-0000-000000000000 (v0, NCS)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.id.DebugString(); got != tt.want {
+				t.Errorf("ID.DebugString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestID_Time(t *testing.T) {
+	for _, millis := range []int64{0, 1, 1709294400123, time.Now().UnixMilli(), 1<<48 - 1} {
+		got, ok := IDv7Deterministic(millis).Time()
+		require.True(t, ok, "v7 has time")
+		require.Equal(t, millis, got.UnixMilli(), "v7 round trip")
+	}
+
+	now := time.Now()
+	got, ok := IDv7().Time()
+	require.True(t, ok, "v7 has time")
+	require.WithinDuration(t, now, got, time.Second)
+
+	got, ok = IDv1().Time()
+	require.True(t, ok, "v1 has time")
+	require.WithinDuration(t, now, got, time.Second)
+
+	got, ok = NamespaceDNS.Time()
+	require.True(t, ok, "v1 has time")
+	require.Equal(t, time.Date(1998, 2, 4, 22, 13, 53, 151182400, time.UTC), got.UTC())
+
+	// Example from RFC 9562 appendix B.2
+	got, ok = IDMustFromString("017f22e2-79b0-7cc3-98c4-dc0c0c07398f").Time()
+	require.True(t, ok, "v7 has time")
+	require.Equal(t, time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC), got.UTC())
+
+	// Example from RFC 9562 appendix B.1
+	got, ok = IDMustFromString("1ec9414c-232a-6b00-b3c8-9f6bdeced846").Time()
+	require.True(t, ok, "v6 has time")
+	require.Equal(t, time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC), got.UTC())
+
+	_, ok = IDv4().Time()
+	require.False(t, ok, "v4 has no time")
+	_, ok = IDv5(NamespaceDNS, "example.com").Time()
+	require.False(t, ok, "v5 has no time")
+	_, ok = IDNil.Time()
+	require.False(t, ok, "nil has no time")
+}
+
 func TestID_Base64(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		id := IDv4()
@@ -834,3 +1042,31 @@ func TestID_PrettyPrint(t *testing.T) {
 		})
 	}
 }
+
+func TestID_Compare(t *testing.T) {
+	a := IDMust("4a6ae04c-8718-4cea-929e-0d8071d328c7")
+	b := IDMust("52d75836-03e0-4b38-8405-bbaa0f392d12")
+	require.Equal(t, 0, a.Compare(a))
+	require.Equal(t, 0, IDNil.Compare(IDNil))
+	require.Equal(t, -1, IDNil.Compare(a))
+	require.Equal(t, +1, a.Compare(IDNil))
+	require.Equal(t, -b.Compare(a), a.Compare(b))
+	require.Equal(t, -1, a.Compare(b), "byte order")
+	// Only the last byte differs, so a little-endian
+	// integer comparison would order them differently
+	require.Equal(t, -1, IDMust("00000000-0000-0000-0000-000000000001").Compare(IDMust("01000000-0000-0000-0000-000000000000")))
+	for i := 0; i < 100; i++ {
+		x, y := IDv4(), IDv4()
+		require.Equal(t, strings.Compare(x.String(), y.String()), x.Compare(y), "same order as strings")
+		require.Equal(t, x.Less(y), x.Compare(y) < 0, "consistent with Less")
+		require.Equal(t, y.Less(x), x.Compare(y) > 0, "consistent with Less")
+	}
+
+	ids := make(IDSlice, 100)
+	for i := range ids {
+		ids[i] = IDv4()
+	}
+	sorted := ids.SortedClone()
+	slices.SortFunc(ids, ID.Compare)
+	require.Equal(t, sorted, ids, "IDSlice.Sort and slices.SortFunc with ID.Compare")
+}
@@ -3,6 +3,7 @@ package uu
 import (
 	"bytes"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"io"
 	"sort"
@@ -62,6 +63,31 @@ func IDSliceMustFromStrings(strs ...string) IDSlice {
 	return s
 }
 
+// IDsFromStrings parses all strings with IDFromString.
+// In case of errors nil is returned together with
+// the joined errors of all invalid strings
+// wrapped with their index.
+func IDsFromStrings(ss []string) ([]ID, error) {
+	if len(ss) == 0 {
+		return nil, nil
+	}
+	var (
+		ids  = make([]ID, len(ss))
+		errs []error
+	)
+	for i, str := range ss {
+		var err error
+		ids[i], err = IDFromString(str)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return ids, nil
+}
+
 // IDSliceMust converts the passed values to an IDSlice
 // or panics if that's not possible or an ID is not valid.
 // Returns nil if zero values are passed.
@@ -239,6 +265,24 @@ func (s *IDSlice) RemoveAt(index int) {
 	*s = append((*s)[:index], (*s)[index+1:]...)
 }
 
+// Dedup returns a copy of the slice with only the
+// first occurrence of every ID, keeping the order.
+// Returns nil for a nil slice.
+func (s IDSlice) Dedup() IDSlice {
+	if s == nil {
+		return nil
+	}
+	result := make(IDSlice, 0, len(s))
+	seen := make(IDSet, len(s))
+	for _, id := range s {
+		if !seen.Contains(id) {
+			seen.Add(id)
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
 // Clone returns a copy of the slice.
 func (s IDSlice) Clone() IDSlice {
 	if s == nil {
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIDSlice(t *testing.T) {
@@ -124,3 +125,60 @@ func TestIDSlice_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestIDSlice_Dedup(t *testing.T) {
+	a := IDMust("4a6ae04c-8718-4cea-929e-0d8071d328c7")
+	b := IDMust("52d75836-03e0-4b38-8405-bbaa0f392d12")
+	assert.Nil(t, IDSlice(nil).Dedup())
+	assert.Equal(t, IDSlice{}, IDSlice{}.Dedup())
+	assert.Equal(t, IDSlice{b, a, IDNil}, IDSlice{b, a, b, IDNil, a, IDNil}.Dedup())
+}
+
+func TestIDsFromStrings(t *testing.T) {
+	ids, err := IDsFromStrings(nil)
+	require.NoError(t, err)
+	require.Nil(t, ids)
+
+	ids, err = IDsFromStrings([]string{
+		"4a6ae04c-8718-4cea-929e-0d8071d328c7",
+		"52d75836-03e0-4b38-8405-bbaa0f392d12",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []ID{IDMust("4a6ae04c-8718-4cea-929e-0d8071d328c7"), IDMust("52d75836-03e0-4b38-8405-bbaa0f392d12")}, ids)
+	require.Equal(t, []string{"4a6ae04c-8718-4cea-929e-0d8071d328c7", "52d75836-03e0-4b38-8405-bbaa0f392d12"}, IDSlice(ids).Strings())
+
+	ids, err = IDsFromStrings([]string{
+		"4a6ae04c-8718-4cea-929e-0d8071d328c7",
+		"not-a-uuid",
+		"52d75836-03e0-4b38-8405-bbaa0f392d12",
+	})
+	require.Nil(t, ids)
+	require.ErrorContains(t, err, "index 1:")
+	require.NotContains(t, err.Error(), "index 0:")
+	require.NotContains(t, err.Error(), "index 2:")
+
+	_, err = IDsFromStrings([]string{"", "4a6ae04c-8718-4cea-929e-0d8071d328c7", "x"})
+	require.ErrorContains(t, err, "index 0:")
+	require.ErrorContains(t, err, "index 2:")
+}
+
+func TestIDSlice_ValueScanRoundTrip(t *testing.T) {
+	tests := []IDSlice{
+		nil,
+		{},
+		{IDNil},
+		IDSliceMustFromStrings("2de0c5e3-d660-4ced-b929-f3a28a42849c", "00000000-0000-0000-0000-000000000000", "2646cba5-4bc6-454f-bdd0-b869a2650f7e"),
+	}
+	for _, s := range tests {
+		value, err := s.Value()
+		require.NoError(t, err)
+		var scanned IDSlice
+		require.NoError(t, scanned.Scan(value))
+		require.Equal(t, s, scanned)
+	}
+
+	// PostgreSQL returns uuid[] without quotes
+	var scanned IDSlice
+	require.NoError(t, scanned.Scan([]byte(`{2de0c5e3-d660-4ced-b929-f3a28a42849c,00000000-0000-0000-0000-000000000000}`)))
+	require.Equal(t, IDSliceMustFromStrings("2de0c5e3-d660-4ced-b929-f3a28a42849c", "00000000-0000-0000-0000-000000000000"), scanned)
+}
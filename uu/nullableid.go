@@ -40,6 +40,20 @@ func NullableIDFromStringOrNull(s string) NullableID {
 	return NullableID(id)
 }
 
+// NullableIDsFromStrings parses all strings with
+// NullableIDFromStringOrNull, so empty and invalid strings
+// are returned as IDNull without failing.
+func NullableIDsFromStrings(ss []string) []NullableID {
+	if len(ss) == 0 {
+		return nil
+	}
+	ids := make([]NullableID, len(ss))
+	for i, str := range ss {
+		ids[i] = NullableIDFromStringOrNull(str)
+	}
+	return ids
+}
+
 // NullableIDFromBytes parses a byte slice as UUID.
 // The Nil UUID "00000000-0000-0000-0000-000000000000"
 // is interpreted as NULL.
@@ -363,6 +377,16 @@ func (n *NullableID) UnmarshalBinary(data []byte) (err error) {
 	return (*ID)(n).UnmarshalBinary(data)
 }
 
+// Compare returns -1 if n is less than the passed other,
+// +1 if it is greater, or 0 if both are equal
+// using the order of ID.Compare.
+// The null ID has only zero bytes
+// so it is always less than a non null ID.
+// Returns the same result as NullableIDCompare.
+func (n NullableID) Compare(other NullableID) int {
+	return ID(n).Compare(ID(other))
+}
+
 // NullableIDCompare returns bytes.Compare result of a and b.
 func NullableIDCompare(a, b NullableID) int {
 	return bytes.Compare(a[:], b[:])
@@ -170,3 +170,52 @@ func TestNullableID_PrettyPrint(t *testing.T) {
 		})
 	}
 }
+
+func TestNullableID_Compare(t *testing.T) {
+	a := NullableIDMust("4a6ae04c-8718-4cea-929e-0d8071d328c7")
+	b := NullableIDMust("52d75836-03e0-4b38-8405-bbaa0f392d12")
+	tests := []struct {
+		n, other NullableID
+		want     int
+	}{
+		{n: IDNull, other: IDNull, want: 0},
+		{n: IDNull, other: a, want: -1},
+		{n: a, other: IDNull, want: +1},
+		{n: a, other: a, want: 0},
+		{n: a, other: b, want: ID(a).Compare(ID(b))},
+		{n: b, other: a, want: ID(b).Compare(ID(a))},
+	}
+	for _, tt := range tests {
+		if got := tt.n.Compare(tt.other); got != tt.want {
+			t.Errorf("NullableID(%s).Compare(%s) = %d, want %d", tt.n, tt.other, got, tt.want)
+		}
+	}
+	if a.Compare(b) == 0 {
+		t.Errorf("different IDs compare as equal")
+	}
+	for i := 0; i < 100; i++ {
+		x, y := IDv4().Nullable(), IDv4().Nullable()
+		for _, pair := range [][2]NullableID{{x, y}, {y, x}, {x, IDNull}, {IDNull, x}} {
+			if got, want := pair[0].Compare(pair[1]), NullableIDCompare(pair[0], pair[1]); got != want {
+				t.Errorf("NullableID(%s).Compare(%s) = %d, NullableIDCompare = %d", pair[0], pair[1], got, want)
+			}
+		}
+	}
+}
+
+func TestNullableIDsFromStrings(t *testing.T) {
+	if ids := NullableIDsFromStrings(nil); ids != nil {
+		t.Errorf("NullableIDsFromStrings(nil) = %v, want nil", ids)
+	}
+	a := NullableIDMust("4a6ae04c-8718-4cea-929e-0d8071d328c7")
+	got := NullableIDsFromStrings([]string{"", a.String(), "not-a-uuid", "00000000-0000-0000-0000-000000000000"})
+	want := []NullableID{IDNull, a, IDNull, IDNull}
+	if len(got) != len(want) {
+		t.Fatalf("NullableIDsFromStrings() returned %d IDs, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("NullableIDsFromStrings()[%d] = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
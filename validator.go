@@ -152,41 +152,88 @@ func TryValidate(v any) (err error, isValidatable bool) {
 
 // DeepValidate validates all fields of a struct, all elements of a slice or array,
 // and all values of a map by recursively calling Validate or Valid methods.
+// Nil pointers are only validated if their type
+// has a pointer receiver Validate or Valid method
+// that can handle nil, else they are skipped.
+// All errors are joined with errors.Join and
+// prefixed with their path, see DeepValidateFunc.
 func DeepValidate(v any) error {
-	return deepValidate(reflect.ValueOf(v))
+	var errs []error
+	DeepValidateFunc(v, func(path string, err error) bool {
+		if path != "" {
+			err = fmt.Errorf("%s: %w", path, err)
+		}
+		errs = append(errs, err)
+		return true
+	})
+	return errors.Join(errs...)
+}
+
+// DeepValidateFunc validates v like DeepValidate but calls yield
+// for every validation error instead of collecting them.
+// The path describes the location of the invalid value within v
+// like "struct field Values -> elememt [1]"
+// and is empty for v itself.
+// The validation stops when yield returns false,
+// so the first error can be handled without validating
+// the rest of a large slice or map.
+func DeepValidateFunc(v any, yield func(path string, err error) bool) {
+	deepValidate(reflect.ValueOf(v), yield)
 }
 
-func deepValidate(v reflect.Value, path ...string) error {
-	err := Validate(v.Interface())
-	if err != nil && len(path) > 0 {
-		err = fmt.Errorf("%s: %w", strings.Join(path, " -> "), err)
+func deepValidate(v reflect.Value, yield func(path string, err error) bool, path ...string) bool {
+	if !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil() && !hasPointerReceiverValidator(v.Type())) {
+		return true
 	}
-	if v.Kind() == reflect.Ptr {
-		if v.IsNil() {
-			return err
+	if err := Validate(v.Interface()); err != nil {
+		if !yield(strings.Join(path, " -> "), err) {
+			return false
 		}
+	}
+	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
 	}
 	switch v.Kind() {
 	case reflect.Struct:
 		for i := 0; i < v.NumField(); i++ {
 			name := fmt.Sprintf("struct field %s", v.Type().Field(i).Name)
-			err = errors.Join(err, deepValidate(v.Field(i), append(path, name)...))
+			if !deepValidate(v.Field(i), yield, append(path, name)...) {
+				return false
+			}
 		}
 	case reflect.Map:
 		keys := v.MapKeys()
 		slices.SortFunc(keys, ReflectCompare)
 		for _, key := range keys {
 			name := fmt.Sprintf("map value [%#v]", key.Interface())
-			err = errors.Join(err, deepValidate(v.MapIndex(key), append(path, name)...))
+			if !deepValidate(v.MapIndex(key), yield, append(path, name)...) {
+				return false
+			}
 		}
 	case reflect.Slice, reflect.Array:
 		for i := 0; i < v.Len(); i++ {
 			name := fmt.Sprintf("elememt [%d]", i)
-			err = errors.Join(err, deepValidate(v.Index(i), append(path, name)...))
+			if !deepValidate(v.Index(i), yield, append(path, name)...) {
+				return false
+			}
 		}
 	}
-	return err
+	return true
+}
+
+var (
+	validatErrType = reflect.TypeFor[ValidatErr]()
+	validatorType  = reflect.TypeFor[Validator]()
+)
+
+// hasPointerReceiverValidator returns if the pointer type ptrType
+// implements ValidatErr or Validator with a pointer receiver method
+// that can be called with a nil pointer.
+// Value receiver methods would panic for a nil pointer.
+func hasPointerReceiverValidator(ptrType reflect.Type) bool {
+	elemType := ptrType.Elem()
+	return ptrType.Implements(validatErrType) && !elemType.Implements(validatErrType) ||
+		ptrType.Implements(validatorType) && !elemType.Implements(validatorType)
 }
 
 // ReflectCompare compares two reflect.Values of the same type.
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -30,3 +32,114 @@ func TestReflectCompare(t *testing.T) {
 		})
 	}
 }
+
+type invalidString string
+
+func (s invalidString) Valid() bool { return s != "invalid" }
+
+type requiredValue struct{}
+
+func (r *requiredValue) Validate() error {
+	if r == nil {
+		return errors.New("nil requiredValue")
+	}
+	return nil
+}
+
+func TestDeepValidate(t *testing.T) {
+	invalid := invalidString("invalid")
+	valid := invalidString("valid")
+
+	if err := DeepValidate(nil); err != nil {
+		t.Errorf("DeepValidate(nil) = %v, want nil", err)
+	}
+	if err := DeepValidate((*invalidString)(nil)); err != nil {
+		t.Errorf("DeepValidate(nil pointer) = %v, want nil", err)
+	}
+	if err := DeepValidate([]*invalidString{nil, &valid, nil}); err != nil {
+		t.Errorf("DeepValidate(valid slice) = %v, want nil", err)
+	}
+
+	err := DeepValidate([]*invalidString{nil, &invalid, nil})
+	if !errors.Is(err, ErrInvalidValue) {
+		t.Fatalf("DeepValidate() = %v, want ErrInvalidValue", err)
+	}
+	want := "elememt [1]: invalid value"
+	if err.Error() != want {
+		t.Errorf("DeepValidate() = %q, want %q", err, want)
+	}
+
+	err = DeepValidate(struct{ Values [2]*invalidString }{Values: [2]*invalidString{&valid, &invalid}})
+	want = "struct field Values -> elememt [1]: invalid value"
+	if err == nil || err.Error() != want {
+		t.Errorf("DeepValidate() = %v, want %q", err, want)
+	}
+
+	// Pointer receiver methods are called with nil pointers
+	err = DeepValidate([]*requiredValue{{}, nil})
+	want = "elememt [1]: nil requiredValue"
+	if err == nil || err.Error() != want {
+		t.Errorf("DeepValidate() = %v, want %q", err, want)
+	}
+	err = DeepValidate(struct{ Value *requiredValue }{})
+	want = "struct field Value: nil requiredValue"
+	if err == nil || err.Error() != want {
+		t.Errorf("DeepValidate() = %v, want %q", err, want)
+	}
+}
+
+func TestDeepValidateFunc(t *testing.T) {
+	invalid := invalidString("invalid")
+	valid := invalidString("valid")
+	values := map[string][]*invalidString{
+		"a": {&invalid, &valid, &invalid},
+		"b": {&valid, nil, &invalid},
+	}
+
+	var paths []string
+	var errs []error
+	DeepValidateFunc(values, func(path string, err error) bool {
+		paths = append(paths, path)
+		errs = append(errs, err)
+		return true
+	})
+	wantPaths := []string{
+		`map value ["a"] -> elememt [0]`,
+		`map value ["a"] -> elememt [2]`,
+		`map value ["b"] -> elememt [2]`,
+	}
+	if !reflect.DeepEqual(paths, wantPaths) {
+		t.Errorf("DeepValidateFunc() paths = %q, want %q", paths, wantPaths)
+	}
+	for _, err := range errs {
+		if err != ErrInvalidValue {
+			t.Errorf("DeepValidateFunc() error = %v, want ErrInvalidValue", err)
+		}
+	}
+
+	// Same errors as DeepValidate
+	var joined []string
+	for i := range paths {
+		joined = append(joined, paths[i]+": "+errs[i].Error())
+	}
+	if got, want := DeepValidate(values).Error(), strings.Join(joined, "\n"); got != want {
+		t.Errorf("DeepValidate() = %q, want %q", got, want)
+	}
+
+	// Stop after first error
+	calls := 0
+	DeepValidateFunc(values, func(path string, err error) bool {
+		calls++
+		return false
+	})
+	if calls != 1 {
+		t.Errorf("DeepValidateFunc() called yield %d times after returning false, want 1", calls)
+	}
+
+	DeepValidateFunc(&invalid, func(path string, err error) bool {
+		if path != "" {
+			t.Errorf("DeepValidateFunc() path = %q for root value, want empty", path)
+		}
+		return true
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/domonda/go-types/country"
 )
 
 var validVATIDs = map[string]string{
@@ -50,6 +52,16 @@ var invalidVATIDs = []ID{
 	" ATU12345678 ",
 	"No. 62-1764389",
 	"No.821764389",
+	"DE111111126",  // Wrong check digit
+	"DE011111125",  // Leading zero
+	"DE11111112",   // Too short
+	"DE1111111250", // Too long
+	"AT10223006",   // Missing U
+	"ATU10223007",  // Wrong check digit
+	"ATU1022300",   // Too short
+	"ATU102230066", // Too long
+	"XX123456789",  // Invalid country
+	"US123456789",  // Unsupported country
 }
 
 func Test_NormalizeVATID(t *testing.T) {
@@ -69,6 +81,14 @@ func Test_VATIDValid(t *testing.T) {
 	}
 }
 
+func TestID_CountryCode(t *testing.T) {
+	assert.Equal(t, country.AT, ID("atu 10223006").CountryCode())
+	assert.Equal(t, country.DE, ID("DE 136725570").CountryCode())
+	assert.Equal(t, country.BE, ID("EU372008134").CountryCode(), "MOSS")
+	assert.Equal(t, country.Invalid, ID("DE111111126").CountryCode())
+	assert.Equal(t, country.Invalid, ID("").CountryCode())
+}
+
 var vatidTestIndices = map[string][][]int{
 	"":                         nil,
 	"ATU10223006":              {{0, 11}},